@@ -0,0 +1,116 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goslugify
+
+// arabicLetters contains a simplified (ASCII only) version of the ALA-LC / UNGEGN romanization of the
+// Arabic alphabet.
+// Letters of other languages written in the Arabic script are included as well, so that for example
+// Persian names in Arabic texts are not dropped.
+var arabicLetters = map[rune]string{
+	'ء': "", 'آ': "a", 'أ': "a", 'ؤ': "u", 'إ': "i", 'ئ': "i", 'ا': "a", 'ب': "b", 'ة': "h", 'ت': "t",
+	'ث': "th", 'ج': "j", 'ح': "h", 'خ': "kh", 'د': "d", 'ذ': "dh", 'ر': "r", 'ز': "z", 'س': "s", 'ش': "sh",
+	'ص': "s", 'ض': "d", 'ط': "t", 'ظ': "z", 'ع': "", 'غ': "gh", 'ف': "f", 'ق': "q", 'ك': "k", 'ل': "l",
+	'م': "m", 'ن': "n", 'ه': "h", 'و': "w", 'ى': "a", 'ي': "y", 'ٱ': "a",
+	'پ': "p", 'چ': "ch", 'ژ': "zh", 'ڤ': "v", 'ک': "k", 'گ': "g", 'ی': "y",
+}
+
+// persianLetters contains the letters of the Persian alphabet that are romanized differently than in
+// Arabic (UNGEGN, without diacritics), all other letters are taken from arabicLetters.
+var persianLetters = map[rune]string{
+	'ث': "s", 'ذ': "z", 'ض': "z", 'و': "v", 'ى': "y", 'ۀ': "eh",
+}
+
+// urduLetters contains the letters of the Urdu alphabet that are romanized differently than in
+// Arabic, all other letters are taken from arabicLetters.
+var urduLetters = map[rune]string{
+	'ث': "s", 'ذ': "z", 'ض': "z", 'ٹ': "t", 'ڈ': "d", 'ڑ': "r", 'ں': "n", 'ھ': "h", 'ہ': "h", 'ۂ': "h",
+	'ۃ': "t", 'ے': "e", 'ۓ': "e", 'ى': "y",
+}
+
+// arabicPunctuation contains the punctuation and separators of the Arabic script.
+var arabicPunctuation = map[rune]string{
+	'،': ",", '؛': ";", '؟': "?", '٪': "%", '٫': ".", '٬': ",", '۔': ".",
+}
+
+// isArabicMark returns true for the harakat (short vowels, sukun, shadda, tanwin), the superscript alef,
+// tatweel and the Quranic annotation signs.
+func isArabicMark(r rune) bool {
+	return r == 'ـ' || (r >= 0x0610 && r <= 0x061A) || (r >= 0x064B && r <= 0x065F) || r == 0x0670 ||
+		(r >= 0x06D6 && r <= 0x06ED)
+}
+
+// isBidiControl returns true for the bidirectional formatting characters (LRM, RLM, ALM, the embeddings,
+// overrides and isolates) and the zero width non-joiner (used in Persian inside words).
+func isBidiControl(r rune) bool {
+	return r == 0x200C || r == 0x200E || r == 0x200F || r == 0x061C || (r >= 0x202A && r <= 0x202E) ||
+		(r >= 0x2066 && r <= 0x2069)
+}
+
+// handleArabicScript handles everything that is common to all languages written in the Arabic script:
+// marks and bidi controls are dropped, Arabic-Indic (U+0660 - U+0669) and Eastern Arabic-Indic
+// (U+06F0 - U+06F9) digits are mapped to ASCII digits and punctuation is mapped to its ASCII counterpart.
+// If none of these applies the letter is looked up in letters and then in arabicLetters.
+func handleArabicScript(r rune, letters map[rune]string) (bool, string) {
+	switch {
+	case isArabicMark(r), isBidiControl(r):
+		return true, ""
+	case r >= '٠' && r <= '٩':
+		return true, string('0' + r - '٠')
+	case r >= '۰' && r <= '۹':
+		return true, string('0' + r - '۰')
+	}
+	if res, has := arabicPunctuation[r]; has {
+		return true, res
+	}
+	if res, has := letters[r]; has {
+		return true, res
+	}
+	if res, has := arabicLetters[r]; has {
+		return true, res
+	}
+	return false, ""
+}
+
+// TransliterateArabic is a RuneHandleFunc that transliterates Arabic according to a simplified
+// (ASCII only) ALA-LC / UNGEGN scheme, for example "القاهرة" --> "alqahrh".
+//
+// Each letter is transliterated on its own, the harakat (short vowels) and tatweel are dropped, thus
+// only the consonants and long vowels are written.
+// Arabic-Indic and Eastern Arabic-Indic digits are mapped to ASCII digits and bidi control characters
+// are ignored (replaced by the empty string).
+//
+// Presentation forms and ligatures are handled as well if the string was normalized with NFKC before
+// (which is the default in the pre-processing phase).
+// All runes that are not part of the Arabic script are not handled.
+func TransliterateArabic(r rune) (bool, string) {
+	return handleArabicScript(r, nil)
+}
+
+// TransliteratePersian is a RuneHandleFunc that transliterates Persian (Farsi), for example
+// "تهران" --> "thran".
+//
+// It works as TransliterateArabic but uses the Persian values of the letters ("و" --> "v", "ذ" --> "z"
+// etc.). The zero width non-joiner is dropped.
+func TransliteratePersian(r rune) (bool, string) {
+	return handleArabicScript(r, persianLetters)
+}
+
+// TransliterateUrdu is a RuneHandleFunc that transliterates Urdu, for example "پاکستان" --> "pakstan".
+//
+// It works as TransliterateArabic but handles the retroflex consonants, "ں" (noon ghunna), the
+// different forms of "he" and "ے" (bari ye).
+func TransliterateUrdu(r rune) (bool, string) {
+	return handleArabicScript(r, urduLetters)
+}
@@ -0,0 +1,54 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goslugify
+
+// ArmenianTable contains the transliteration of Armenian according to BGN/PCGN (1981) without
+// the apostrophes that mark aspirated consonants.
+var ArmenianTable = StringReplaceMap{
+	"ա": "a", "բ": "b", "գ": "g", "դ": "d", "ե": "e", "զ": "z", "է": "e", "ը": "y", "թ": "t", "ժ": "zh",
+	"ի": "i", "լ": "l", "խ": "kh", "ծ": "ts", "կ": "k", "հ": "h", "ձ": "dz", "ղ": "gh", "ճ": "ch", "մ": "m",
+	"յ": "y", "ն": "n", "շ": "sh", "ո": "o", "չ": "ch", "պ": "p", "ջ": "j", "ռ": "r", "ս": "s", "վ": "v",
+	"տ": "t", "ր": "r", "ց": "ts", "ւ": "w", "փ": "p", "ք": "k", "օ": "o", "ֆ": "f", "և": "ev",
+	"ու": "u", "եւ": "ev",
+	"։": ".", "՝": "", "՛": "", "՜": "", "՞": "", "՚": "",
+}
+
+// armenianVowels are the vowels after which "ե" is transliterated to "ye".
+const armenianVowels = "աեէըիոօ"
+
+// NewArmenianTransliterator returns a transliterator that converts Armenian to Latin according to BGN/PCGN
+// (without apostrophes), for example "Երևան" --> "Yerevan".
+//
+// "ու" becomes "u", "ե" becomes "ye" at the beginning of a word and after vowels and "ո" becomes "vo" at
+// the beginning of a word (but "ով" is "ov").
+// Use the HandleRune method of the result if you need a RuneHandleFunc (letter by letter, without
+// these rules).
+func NewArmenianTransliterator() *TableTransliterator {
+	table := MergeStringReplaceMaps(ArmenianTable)
+	for _, vowel := range armenianVowels {
+		table[string(vowel)+"ե"] = ArmenianTable[string(vowel)] + "ye"
+	}
+	// "ու" is a vowel as well
+	table["ուե"] = "uye"
+	initial := StringReplaceMap{
+		"ե":  "ye",
+		"եւ": "yev",
+		"և":  "yev",
+		"ո":  "vo",
+		"ով": "ov",
+		"ու": "u",
+	}
+	return NewTableTransliterator(table, initial, nil)
+}
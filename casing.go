@@ -0,0 +1,89 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goslugify
+
+import (
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+)
+
+// specialCaseLanguages contains the base languages with language specific case mapping rules in the
+// cases package.
+var specialCaseLanguages = []language.Base{
+	language.MustParseBase("tr"),
+	language.MustParseBase("az"),
+	language.MustParseBase("lt"),
+}
+
+// caseLanguage returns the first language with language specific case mapping rules (Turkish, Azerbaijani
+// and Lithuanian), if there is no such language language.Und is returned.
+// Languages that can't be parsed are ignored.
+func caseLanguage(languages ...string) language.Tag {
+	for _, l := range languages {
+		tag, err := language.Parse(l)
+		if err != nil {
+			continue
+		}
+		base, _ := tag.Base()
+		for _, special := range specialCaseLanguages {
+			if base == special {
+				return tag
+			}
+		}
+	}
+	return language.Und
+}
+
+// NewLowerCaseFunc returns a StringModifierFunc that converts the string to lower case according to the
+// rules of the given languages (see https://godoc.org/golang.org/x/text/cases).
+//
+// The first language with language specific rules is used, these are "tr" and "az" (the dotted and dotless i:
+// "İSTANBUL" becomes "istanbul" and "IĞDIR" becomes "ığdır") and "lt" (the dot above i is kept if i has
+// another accent). If there is no such language the rules for all languages are used, in contrast to
+// strings.ToLower this includes the Greek final sigma: "ΟΔΥΣΣΕΥΣ" becomes "οδυσσευς".
+func NewLowerCaseFunc(languages ...string) StringModifierFunc {
+	tag := caseLanguage(languages...)
+	return func(in string) string {
+		// a caser is stateful and must not be used concurrently, so we create a new one
+		return cases.Lower(tag).String(in)
+	}
+}
+
+// NewCaseFoldFunc returns a StringModifierFunc that applies full Unicode case folding to the string: Case
+// differences are removed for comparison, for example "ß" becomes "ss" and "ς" becomes "σ", so "Straße" and
+// "STRASSE" are both folded to "strasse".
+//
+// If one of the given languages has language specific case mapping rules (see NewLowerCaseFunc) the string
+// is first converted to lower case according to these rules, thus "İSTANBUL" becomes "istanbul" for
+// Turkish.
+//
+// Note that case folding doesn't preserve normal forms, the string should be normalized again afterwards.
+func NewCaseFoldFunc(languages ...string) StringModifierFunc {
+	tag := caseLanguage(languages...)
+	return func(in string) string {
+		if tag != language.Und {
+			in = cases.Lower(tag).String(in)
+		}
+		return cases.Fold().String(in)
+	}
+}
+
+// isCaseFolded returns true if s doesn't change by case folding followed by normalization to form.
+// The whole string must be checked: Case folding can decompose a rune ("ΐ" becomes "ι" followed by two
+// combining marks) and the normalization composes it again.
+func isCaseFolded(s string, form norm.Form) bool {
+	return NewUTF8Normalizer(form).Modify(cases.Fold().String(s)) == s
+}
@@ -0,0 +1,69 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goslugify
+
+import "strings"
+
+// PinyinTable contains the toneless Pinyin of Han characters (simplified and common traditional ones).
+// The values are surrounded by spaces, see NewPinyinTransliterator.
+//
+// This table is embedded in the package and covers the commonly used characters, characters that are not
+// in this table are dropped by the default processors. If you need more characters or different readings
+// create a transliterator with NewPinyinTransliterator and add your own entries.
+var PinyinTable = parsePinyinCharacters(pinyinCharacterData)
+
+// PinyinPhraseTable contains phrases in which a character has a reading different from its entry in
+// PinyinTable, for example "银行" --> "yin hang" ("行" is usually "xing").
+// As in PinyinTable the values are surrounded by spaces.
+var PinyinPhraseTable = parsePinyinPhrases(pinyinPhraseData)
+
+func parsePinyinCharacters(data string) StringReplaceMap {
+	res := make(StringReplaceMap)
+	for _, line := range strings.Split(data, "\n") {
+		split := strings.SplitN(line, " ", 2)
+		if len(split) != 2 {
+			continue
+		}
+		syllable := " " + split[0] + " "
+		for _, r := range split[1] {
+			res[string(r)] = syllable
+		}
+	}
+	return res
+}
+
+func parsePinyinPhrases(data string) StringReplaceMap {
+	res := make(StringReplaceMap)
+	for _, line := range strings.Split(data, "\n") {
+		split := strings.SplitN(line, " ", 2)
+		if len(split) != 2 {
+			continue
+		}
+		res[split[0]] = " " + split[1] + " "
+	}
+	return res
+}
+
+// NewPinyinTransliterator returns a transliterator that converts Chinese (Han characters) to toneless Pinyin.
+//
+// Each syllable is surrounded by spaces, the default processors replace spaces by the word separator,
+// thus "北京大学" becomes "bei-jing-da-xue".
+// Phrases from PinyinPhraseTable are used for characters with more than one reading.
+// "ü" is written as "v", for example "女" --> "nv".
+//
+// Each call returns a new transliterator, so it's safe to add entries to the tables of the result.
+func NewPinyinTransliterator() *TableTransliterator {
+	return NewTableTransliterator(MergeStringReplaceMaps(PinyinPhraseTable, PinyinTable), nil, nil)
+}
@@ -0,0 +1,314 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goslugify
+
+import (
+	"golang.org/x/text/unicode/norm"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// ConfusableTable maps runes to their prototype as defined in the confusables data of Unicode Technical
+// Standard #39 (confusables.txt), for example the Cyrillic "а" is mapped to the Latin "a".
+//
+// The table embedded in this package contains only a subset of the data: The letters of Cyrillic, Greek and
+// Armenian that look like Latin letters and the most common confusables within ASCII ("1" --> "l",
+// "0" --> "O", "m" --> "rn"). Compatibility characters (fullwidth forms, mathematical letters etc.) are not
+// included because they're handled by NFKC.
+// Entries can be added to the table, changes are used by all functions of this package.
+var ConfusableTable = map[rune]string{
+	// ASCII
+	'0': "O", '1': "l", 'I': "l", '|': "l", 'm': "rn",
+	// Latin
+	'ı': "i", 'ɑ': "a", 'ɡ': "g", 'ɩ': "i", 'ǀ': "l", 'ȷ': "j",
+	// Cyrillic
+	'а': "a", 'е': "e", 'о': "o", 'р': "p", 'с': "c", 'у': "y", 'х': "x", 'ѕ': "s", 'і': "i", 'ј': "j",
+	'һ': "h", 'ԁ': "d", 'ԛ': "q", 'ԝ': "w", 'ӏ': "l",
+	'А': "A", 'В': "B", 'Е': "E", 'К': "K", 'М': "M", 'Н': "H", 'О': "O", 'Р': "P", 'С': "C", 'Т': "T",
+	'Х': "X", 'Ѕ': "S", 'І': "l", 'Ј': "J", 'Ү': "Y", 'Ԛ': "Q", 'Ԝ': "W", 'Ӏ': "l", 'З': "3",
+	// Greek
+	'α': "a", 'ο': "o", 'ν': "v", 'ρ': "p", 'ι': "i", 'γ': "y",
+	'Α': "A", 'Β': "B", 'Ε': "E", 'Ζ': "Z", 'Η': "H", 'Ι': "l", 'Κ': "K", 'Μ': "M", 'Ν': "N", 'Ο': "O",
+	'Ρ': "P", 'Τ': "T", 'Υ': "Y", 'Χ': "X",
+	// Armenian
+	'օ': "o", 'ս': "u", 'ց': "g", 'հ': "h", 'ո': "n", 'Ս': "U", 'Օ': "O",
+}
+
+// Skeleton returns the skeleton of s as defined in Unicode Technical Standard #39: s is decomposed (NFD),
+// each rune is replaced by its prototype from ConfusableTable and the result is decomposed again.
+// Two strings that look the same have the same skeleton, for example "раypal" (with the Cyrillic "р" and
+// "а") and "paypal".
+//
+// Note that the skeleton is only meant for comparison, it shouldn't be displayed.
+func Skeleton(s string) string {
+	var buf strings.Builder
+	for _, r := range norm.NFD.String(s) {
+		if prototype, has := ConfusableTable[r]; has {
+			buf.WriteString(prototype)
+		} else {
+			buf.WriteRune(r)
+		}
+	}
+	return norm.NFD.String(buf.String())
+}
+
+// AreConfusable returns true if a and b are visually confusable, i.e. if they have the same skeleton.
+// Use Skeleton to store the skeletons of slugs if you want to make sure that no two slugs look the same.
+func AreConfusable(a, b string) bool {
+	return Skeleton(a) == Skeleton(b)
+}
+
+// RestrictionLevel is the restriction level of a string as defined in Unicode Technical Standard #39.
+type RestrictionLevel int
+
+const (
+	// ASCIIOnly means that all runes are ASCII.
+	ASCIIOnly RestrictionLevel = iota
+	// SingleScript means that all runes belong to one script (runes that are used in all scripts like digits
+	// and punctuation are ignored).
+	SingleScript
+	// HighlyRestrictive means that the runes belong to Latin, Han, Hiragana and Katakana, Latin, Han and
+	// Bopomofo or Latin, Han and Hangul (the scripts commonly mixed in Japanese, Chinese and Korean).
+	HighlyRestrictive
+	// ModeratelyRestrictive means that the runes belong to Latin and one other script that is not Cyrillic or
+	// Greek.
+	ModeratelyRestrictive
+	// MinimallyRestrictive means that the runes belong to arbitrary scripts.
+	MinimallyRestrictive
+)
+
+// commonScripts contains the scripts that are checked first by runeScript.
+var commonScripts = []*unicode.RangeTable{unicode.Latin, unicode.Common, unicode.Inherited, unicode.Cyrillic,
+	unicode.Greek, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul, unicode.Arabic, unicode.Hebrew}
+
+// runeScript returns the script of a rune.
+func runeScript(r rune) *unicode.RangeTable {
+	for _, script := range commonScripts {
+		if unicode.Is(script, r) {
+			return script
+		}
+	}
+	for _, script := range unicode.Scripts {
+		if unicode.Is(script, r) {
+			return script
+		}
+	}
+	return nil
+}
+
+// scriptCounts returns how many runes of s belong to each script, runes of Common and Inherited are ignored.
+func scriptCounts(s string) map[*unicode.RangeTable]int {
+	res := make(map[*unicode.RangeTable]int)
+	for _, r := range s {
+		script := runeScript(r)
+		if script != nil && script != unicode.Common && script != unicode.Inherited {
+			res[script]++
+		}
+	}
+	return res
+}
+
+// highlyRestrictiveScripts contains the sets of scripts that are allowed in HighlyRestrictive.
+var highlyRestrictiveScripts = [][]*unicode.RangeTable{
+	{unicode.Latin, unicode.Han, unicode.Hiragana, unicode.Katakana},
+	{unicode.Latin, unicode.Han, unicode.Bopomofo},
+	{unicode.Latin, unicode.Han, unicode.Hangul},
+}
+
+func scriptsCoveredBy(counts map[*unicode.RangeTable]int, scripts []*unicode.RangeTable) bool {
+	for script := range counts {
+		covered := false
+		for _, allowed := range scripts {
+			if script == allowed {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+// IsMixedScript returns true if s contains runes of more than one script (runes that are used in all
+// scripts like digits and punctuation are ignored), for example "раypal" with the Cyrillic "р" and "а".
+func IsMixedScript(s string) bool {
+	return len(scriptCounts(s)) > 1
+}
+
+// GetRestrictionLevel returns the restriction level of s as defined in Unicode Technical Standard #39.
+// Strings with a level above ModeratelyRestrictive should be considered as a security risk.
+//
+// Note that the script of a rune is used (not the script extensions) and that it is not checked if s is a valid
+// identifier, so the level "Unrestricted" is never returned.
+func GetRestrictionLevel(s string) RestrictionLevel {
+	ascii := true
+	for _, r := range s {
+		if r > unicode.MaxASCII {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return ASCIIOnly
+	}
+	counts := scriptCounts(s)
+	if len(counts) <= 1 {
+		return SingleScript
+	}
+	for _, scripts := range highlyRestrictiveScripts {
+		if scriptsCoveredBy(counts, scripts) {
+			return HighlyRestrictive
+		}
+	}
+	if len(counts) == 2 && counts[unicode.Latin] > 0 && counts[unicode.Cyrillic] == 0 &&
+		counts[unicode.Greek] == 0 {
+		return ModeratelyRestrictive
+	}
+	return MinimallyRestrictive
+}
+
+// ConfusableMode describes how slugs with words that mix scripts are handled, see NewMixedScriptFunc.
+type ConfusableMode int
+
+const (
+	// ConfusableAllow keeps all slugs.
+	ConfusableAllow ConfusableMode = iota
+	// ConfusableFold replaces confusable runes by the runes of the main script of the word.
+	ConfusableFold
+	// ConfusableReject replaces slugs with a risky word by the empty string.
+	ConfusableReject
+)
+
+// isRiskyWord returns true if the restriction level of the word is above ModeratelyRestrictive.
+func isRiskyWord(word string) bool {
+	return GetRestrictionLevel(word) > ModeratelyRestrictive
+}
+
+// hasRiskyWord returns true if s contains a word (separated by separator) that is risky.
+func hasRiskyWord(s string, separator rune) bool {
+	for _, word := range strings.Split(s, string(separator)) {
+		if isRiskyWord(word) {
+			return true
+		}
+	}
+	return false
+}
+
+// foldWord replaces all runes of word that don't belong to the main script (the script with the most runes)
+// by a rune of the main script with the same prototype, if such a rune exists. A lower case rune is only
+// replaced by a lower case rune, so "l" in a Cyrillic word becomes "ӏ" and not "Ӏ".
+func foldWord(word string, prototypes map[string][]rune) string {
+	counts := scriptCounts(word)
+	var main *unicode.RangeTable
+	for script, count := range counts {
+		if main == nil || count > counts[main] || (count == counts[main] && script == unicode.Latin) {
+			main = script
+		}
+	}
+	var buf strings.Builder
+	for _, r := range word {
+		script := runeScript(r)
+		if script == main || script == unicode.Common || script == unicode.Inherited {
+			buf.WriteRune(r)
+			continue
+		}
+		prototype, has := ConfusableTable[r]
+		if !has {
+			prototype = string(r)
+		}
+		replaced := false
+		for _, candidate := range prototypes[prototype] {
+			if unicode.Is(main, candidate) && (!unicode.IsLower(r) || unicode.IsLower(candidate)) {
+				buf.WriteRune(candidate)
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			buf.WriteRune(r)
+		}
+	}
+	return buf.String()
+}
+
+// confusablePrototypes returns all runes with the same prototype for each prototype of ConfusableTable,
+// the prototype itself comes first and the other runes are sorted, so the result of foldWord is always the
+// same.
+func confusablePrototypes() map[string][]rune {
+	keys := make([]rune, 0, len(ConfusableTable))
+	for r := range ConfusableTable {
+		keys = append(keys, r)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	prototypes := make(map[string][]rune)
+	for _, r := range keys {
+		prototype := ConfusableTable[r]
+		if runes := []rune(prototype); len(runes) == 1 && len(prototypes[prototype]) == 0 {
+			prototypes[prototype] = runes
+		}
+	}
+	for _, r := range keys {
+		prototype := ConfusableTable[r]
+		prototypes[prototype] = append(prototypes[prototype], r)
+	}
+	return prototypes
+}
+
+// NewMixedScriptFunc returns a StringModifierFunc that protects against homograph attacks in slugs that
+// contain Unicode letters (see SlugConfig.AllowUnicode), for example "раypal" with the Cyrillic "р" and "а".
+//
+// The string is split into words by separator, a word is risky if its restriction level is above
+// ModeratelyRestrictive (see GetRestrictionLevel). So "москва-moscow" is fine but "раypal" is not.
+// With ConfusableFold the runes of a risky word that don't belong to the script with the most runes in that
+// word are replaced by a rune of that script with the same prototype (see ConfusableTable), thus "раypal"
+// becomes "paypal". Runes without such a counterpart are not changed, if the word is still risky after that
+// it is removed ("жpaypal" can't be folded). Thus the result doesn't contain risky words.
+// With ConfusableReject the empty string is returned if s contains a risky word.
+// With ConfusableAllow the string is not changed.
+func NewMixedScriptFunc(mode ConfusableMode, separator rune) StringModifierFunc {
+	switch mode {
+	case ConfusableReject:
+		return func(in string) string {
+			if hasRiskyWord(in, separator) {
+				return ""
+			}
+			return in
+		}
+	case ConfusableFold:
+		prototypes := confusablePrototypes()
+		return func(in string) string {
+			words := strings.Split(in, string(separator))
+			res := make([]string, 0, len(words))
+			for _, word := range words {
+				if isRiskyWord(word) {
+					word = foldWord(word, prototypes)
+					// the word can't be folded completely
+					if isRiskyWord(word) {
+						continue
+					}
+				}
+				res = append(res, word)
+			}
+			return strings.Join(res, string(separator))
+		}
+	default:
+		return func(in string) string {
+			return in
+		}
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goslugify
+
+import "strings"
+
+// CyrillicStandard describes the romanization standard used to transliterate Cyrillic.
+type CyrillicStandard int
+
+const (
+	// CyrillicBGNPCGN is the BGN/PCGN romanization. For languages where BGN/PCGN adopted the
+	// official national system (Ukrainian, Bulgarian, Serbian, Macedonian) this national system is used.
+	// This is the default standard.
+	CyrillicBGNPCGN CyrillicStandard = iota
+	// CyrillicISO9 is ISO 9:1995, a one to one mapping that uses diacritics
+	// (TranslateDiacritics removes them in the default processors).
+	CyrillicISO9
+	// CyrillicGOST779B is GOST 7.79-2000 system B which uses only ASCII letters.
+	CyrillicGOST779B
+)
+
+// CyrillicISO9Table is the transliteration table for ISO 9:1995.
+var CyrillicISO9Table = StringReplaceMap{
+	"а": "a", "б": "b", "в": "v", "г": "g", "ґ": "g̀", "д": "d", "ѓ": "ǵ", "ђ": "đ",
+	"е": "e", "ё": "ë", "є": "ê", "ж": "ž", "з": "z", "ѕ": "ẑ", "и": "i", "і": "ì",
+	"ї": "ï", "й": "j", "ј": "ǰ", "к": "k", "л": "l", "љ": "l̂", "м": "m", "н": "n",
+	"њ": "n̂", "о": "o", "п": "p", "р": "r", "с": "s", "т": "t", "ќ": "ḱ", "ћ": "ć",
+	"у": "u", "ў": "ŭ", "ф": "f", "х": "h", "ц": "c", "ч": "č", "џ": "d̂", "ш": "š",
+	"щ": "ŝ", "ъ": "ʺ", "ы": "y", "ь": "ʹ", "э": "è", "ю": "û", "я": "â",
+}
+
+// CyrillicGOST779BTable is the transliteration table for GOST 7.79-2000 system B.
+// The context rule for "ц" ("c" before i, e, y and j, otherwise "cz") is not part of this table,
+// see NewCyrillicTransliterator.
+var CyrillicGOST779BTable = StringReplaceMap{
+	"а": "a", "б": "b", "в": "v", "г": "g", "ґ": "g`", "д": "d", "ѓ": "g`", "е": "e",
+	"ё": "yo", "є": "ye", "ж": "zh", "з": "z", "ѕ": "z`", "и": "i", "і": "i", "ї": "yi",
+	"й": "j", "ј": "j", "к": "k", "ќ": "k`", "л": "l", "љ": "l`", "м": "m", "н": "n",
+	"њ": "n`", "о": "o", "п": "p", "р": "r", "с": "s", "т": "t", "у": "u", "ў": "u`",
+	"ф": "f", "х": "x", "ц": "cz", "ч": "ch", "џ": "dh", "ш": "sh", "щ": "shh", "ъ": "``",
+	"ы": "y`", "ь": "`", "э": "e`", "ю": "yu", "я": "ya",
+}
+
+// cyrillicGOST779BOverrides contains the language specific entries of GOST 7.79-2000 system B.
+var cyrillicGOST779BOverrides = map[string]StringReplaceMap{
+	LanguageUkrainian: {"и": "y`", "'": "``", "’": "``"},
+	LanguageBulgarian: {"ъ": "a`"},
+}
+
+// cyrillicBGNPCGN contains for each supported language the table, the initial and final table and the
+// vowels after which the initial form is used as well.
+var cyrillicBGNPCGN = map[string]struct {
+	table, initial, final StringReplaceMap
+	vowels                string
+}{
+	// BGN/PCGN 1947 (with "ye" for "е" initially and after vowels, "ъ" and "ь" dropped)
+	LanguageRussian: {
+		table: StringReplaceMap{
+			"а": "a", "б": "b", "в": "v", "г": "g", "д": "d", "е": "e", "ё": "ë", "ж": "zh",
+			"з": "z", "и": "i", "й": "y", "к": "k", "л": "l", "м": "m", "н": "n", "о": "o",
+			"п": "p", "р": "r", "с": "s", "т": "t", "у": "u", "ф": "f", "х": "kh", "ц": "ts",
+			"ч": "ch", "ш": "sh", "щ": "shch", "ъ": "", "ы": "y", "ь": "", "э": "e", "ю": "yu",
+			"я": "ya",
+		},
+		initial: StringReplaceMap{"е": "ye", "ё": "yë"},
+		vowels:  "аеёиоуыэюяйъь",
+	},
+	// Ukrainian national system (KMU 2010), adopted by BGN/PCGN in 2019
+	LanguageUkrainian: {
+		table: StringReplaceMap{
+			"а": "a", "б": "b", "в": "v", "г": "h", "ґ": "g", "д": "d", "е": "e", "є": "ie",
+			"ж": "zh", "з": "z", "зг": "zgh", "и": "y", "і": "i", "ї": "i", "й": "i", "к": "k",
+			"л": "l", "м": "m", "н": "n", "о": "o", "п": "p", "р": "r", "с": "s", "т": "t",
+			"у": "u", "ф": "f", "х": "kh", "ц": "ts", "ч": "ch", "ш": "sh", "щ": "shch", "ь": "",
+			"ю": "iu", "я": "ia", "'": "", "’": "",
+		},
+		initial: StringReplaceMap{"є": "ye", "ї": "yi", "й": "y", "ю": "yu", "я": "ya"},
+		vowels:  "",
+	},
+	// Bulgarian Streamlined System (2009), adopted by BGN/PCGN in 2013
+	LanguageBulgarian: {
+		table: StringReplaceMap{
+			"а": "a", "б": "b", "в": "v", "г": "g", "д": "d", "е": "e", "ж": "zh", "з": "z",
+			"и": "i", "й": "y", "к": "k", "л": "l", "м": "m", "н": "n", "о": "o", "п": "p",
+			"р": "r", "с": "s", "т": "t", "у": "u", "ф": "f", "х": "h", "ц": "ts", "ч": "ch",
+			"ш": "sh", "щ": "sht", "ъ": "a", "ь": "y", "ю": "yu", "я": "ya",
+		},
+		initial: nil,
+		final:   StringReplaceMap{"ия": "ia"},
+		vowels:  "",
+	},
+	// Serbian Latin alphabet (Gaj), "ђ" is written as "dj" as usual without diacritics
+	LanguageSerbian: {
+		table: StringReplaceMap{
+			"а": "a", "б": "b", "в": "v", "г": "g", "д": "d", "ђ": "dj", "е": "e", "ж": "ž",
+			"з": "z", "и": "i", "ј": "j", "к": "k", "л": "l", "љ": "lj", "м": "m", "н": "n",
+			"њ": "nj", "о": "o", "п": "p", "р": "r", "с": "s", "т": "t", "ћ": "ć", "у": "u",
+			"ф": "f", "х": "h", "ц": "c", "ч": "č", "џ": "dž", "ш": "š",
+		},
+		initial: nil,
+		vowels:  "",
+	},
+	// Macedonian official romanization (2008)
+	LanguageMacedonian: {
+		table: StringReplaceMap{
+			"а": "a", "б": "b", "в": "v", "г": "g", "д": "d", "ѓ": "gj", "е": "e", "ж": "zh",
+			"з": "z", "ѕ": "dz", "и": "i", "ј": "j", "к": "k", "л": "l", "љ": "lj", "м": "m",
+			"н": "n", "њ": "nj", "о": "o", "п": "p", "р": "r", "с": "s", "т": "t", "ќ": "kj",
+			"у": "u", "ф": "f", "х": "h", "ц": "c", "ч": "ch", "џ": "dzh", "ш": "sh",
+		},
+		initial: nil,
+		vowels:  "",
+	},
+	// BGN/PCGN 1979 for Belarusian
+	LanguageBelarusian: {
+		table: StringReplaceMap{
+			"а": "a", "б": "b", "в": "v", "г": "h", "д": "d", "е": "ye", "ё": "yo", "ж": "zh",
+			"з": "z", "і": "i", "й": "y", "к": "k", "л": "l", "м": "m", "н": "n", "о": "o",
+			"п": "p", "р": "r", "с": "s", "т": "t", "у": "u", "ў": "w", "ф": "f", "х": "kh",
+			"ц": "ts", "ч": "ch", "ш": "sh", "ы": "y", "ь": "", "э": "e", "ю": "yu", "я": "ya",
+			"'": "", "’": "",
+		},
+		initial: nil,
+		vowels:  "",
+	},
+}
+
+// NewCyrillicTransliterator returns a transliterator for Cyrillic given the standard and the language.
+// The language is one of "ru", "uk", "bg", "sr", "mk" and "be", for ISO 9 the language is ignored.
+// If a standard doesn't have rules for a language the Russian rules are used.
+//
+// Letters that don't belong to the alphabet of the language (for example Russian text with the
+// Serbian rules) are transliterated with the Russian rules and ISO 9 as a fallback.
+func NewCyrillicTransliterator(standard CyrillicStandard, language string) *TableTransliterator {
+	switch standard {
+	case CyrillicISO9:
+		return NewTableTransliterator(CyrillicISO9Table, nil, nil)
+	case CyrillicGOST779B:
+		table := MergeStringReplaceMaps(cyrillicGOST779BOverrides[language], CyrillicGOST779BTable)
+		// "ц" is written as "c" if followed by a letter that starts with i, e, y or j
+		// the pairs are collected first, keys added to a map while ranging over it may or may not be visited
+		pairs := make(StringReplaceMap)
+		for key, value := range table {
+			if value != "" && strings.ContainsAny(value[:1], "eijy") {
+				pairs["ц"+key] = "c" + value
+			}
+		}
+		return NewTableTransliterator(MergeStringReplaceMaps(pairs, table), nil, nil)
+	default:
+		entry, has := cyrillicBGNPCGN[language]
+		if !has {
+			entry = cyrillicBGNPCGN[LanguageRussian]
+		}
+		table := MergeStringReplaceMaps(entry.table, cyrillicBGNPCGN[LanguageRussian].table, CyrillicISO9Table)
+		// the initial forms are also used after the vowels, for this add pairs (vowel, letter)
+		for _, vowel := range entry.vowels {
+			vowelRes := table[string(vowel)]
+			for key, value := range entry.initial {
+				table[string(vowel)+key] = vowelRes + value
+			}
+		}
+		return NewTableTransliterator(table, entry.initial, entry.final)
+	}
+}
@@ -0,0 +1,423 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goslugify
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+)
+
+// DictionaryError is returned if a dictionary (see LoadReplaceMap) is not valid.
+// File is the name of the file (empty if the dictionary is not read from a file) and Line the line in the
+// dictionary where the error occurred (0 if the line is unknown).
+type DictionaryError struct {
+	File    string
+	Line    int
+	Message string
+}
+
+func (err *DictionaryError) Error() string {
+	switch {
+	case err.File != "" && err.Line > 0:
+		return fmt.Sprintf("invalid dictionary %s in line %d: %s", err.File, err.Line, err.Message)
+	case err.File != "":
+		return fmt.Sprintf("invalid dictionary %s: %s", err.File, err.Message)
+	case err.Line > 0:
+		return fmt.Sprintf("invalid dictionary in line %d: %s", err.Line, err.Message)
+	default:
+		return "invalid dictionary: " + err.Message
+	}
+}
+
+// DictionaryFormat is the file format of a dictionary, see LoadReplaceMap.
+type DictionaryFormat int
+
+const (
+	// DictionaryJSON is a JSON object with string values: {"&": "and", "@": "at"}
+	DictionaryJSON DictionaryFormat = iota
+	// DictionaryYAML is a YAML mapping with string values.
+	DictionaryYAML
+	// DictionaryCSV contains one entry per line: The key in the first column and the replacement in the
+	// second one. Lines starting with an unquoted "#" are comments, for example a header, so a key that
+	// starts with "#" must be quoted: "#",hash. Each record must be on a single line.
+	DictionaryCSV
+)
+
+// DictionaryFormatFromName returns the format of a dictionary file given its extension: ".json", ".yaml"
+// (or ".yml") and ".csv".
+func DictionaryFormatFromName(name string) (DictionaryFormat, error) {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".json":
+		return DictionaryJSON, nil
+	case ".yaml", ".yml":
+		return DictionaryYAML, nil
+	case ".csv":
+		return DictionaryCSV, nil
+	default:
+		return 0, fmt.Errorf("unknown dictionary format of \"%s\"", name)
+	}
+}
+
+// dictionaryEntry is an entry of a dictionary together with its line.
+type dictionaryEntry struct {
+	key, value string
+	line       int
+}
+
+// lineAt returns the line of the byte offset in content.
+func lineAt(content []byte, offset int64) int {
+	if offset > int64(len(content)) {
+		offset = int64(len(content))
+	}
+	return bytes.Count(content[:offset], []byte("\n")) + 1
+}
+
+func parseJSONDictionary(content []byte) ([]dictionaryEntry, error) {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	errorAt := func(err error) error {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			return &DictionaryError{Line: lineAt(content, syntaxErr.Offset), Message: syntaxErr.Error()}
+		}
+		return &DictionaryError{Line: lineAt(content, decoder.InputOffset()), Message: err.Error()}
+	}
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, errorAt(err)
+	}
+	if token != json.Delim('{') {
+		return nil, &DictionaryError{Line: lineAt(content, decoder.InputOffset()),
+			Message: "dictionary must be a JSON object"}
+	}
+	var res []dictionaryEntry
+	for decoder.More() {
+		token, err = decoder.Token()
+		if err != nil {
+			return nil, errorAt(err)
+		}
+		key := token.(string)
+		line := lineAt(content, decoder.InputOffset())
+		token, err = decoder.Token()
+		if err != nil {
+			return nil, errorAt(err)
+		}
+		value, isString := token.(string)
+		if !isString {
+			return nil, &DictionaryError{Line: line, Message: fmt.Sprintf("value of \"%s\" is not a string", key)}
+		}
+		res = append(res, dictionaryEntry{key, value, line})
+	}
+	if _, err := decoder.Token(); err != nil {
+		return nil, errorAt(err)
+	}
+	return res, nil
+}
+
+func parseYAMLDictionary(content []byte) ([]dictionaryEntry, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		// the errors of the yaml package have the form "yaml: line 2: ..."
+		var line int
+		message := strings.TrimPrefix(err.Error(), "yaml: ")
+		if _, scanErr := fmt.Sscanf(message, "line %d:", &line); scanErr == nil {
+			message = strings.TrimSpace(message[strings.Index(message, ":")+1:])
+		}
+		return nil, &DictionaryError{Line: line, Message: message}
+	}
+	// an empty document
+	if len(document.Content) == 0 {
+		return nil, nil
+	}
+	mapping := document.Content[0]
+	if mapping.Kind != yaml.MappingNode {
+		return nil, &DictionaryError{Line: mapping.Line, Message: "dictionary must be a YAML mapping"}
+	}
+	res := make([]dictionaryEntry, 0, len(mapping.Content)/2)
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		key, value := mapping.Content[i], mapping.Content[i+1]
+		if key.Kind != yaml.ScalarNode {
+			return nil, &DictionaryError{Line: key.Line, Message: "key is not a string"}
+		}
+		if value.Kind != yaml.ScalarNode {
+			return nil, &DictionaryError{Line: value.Line,
+				Message: fmt.Sprintf("value of \"%s\" is not a string", key.Value)}
+		}
+		// an empty value ("key:" or "key: ~") is the empty string
+		replaceBy := value.Value
+		if value.Tag == "!!null" {
+			replaceBy = ""
+		}
+		res = append(res, dictionaryEntry{key.Value, replaceBy, key.Line})
+	}
+	return res, nil
+}
+
+func parseCSVDictionary(content []byte) ([]dictionaryEntry, error) {
+	var res []dictionaryEntry
+	for i, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		// a quoted "#" starts with a quote, so it is not a comment
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		reader := csv.NewReader(strings.NewReader(line))
+		record, err := reader.Read()
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				err = parseErr.Err
+			}
+			return nil, &DictionaryError{Line: i + 1, Message: err.Error()}
+		}
+		if len(record) != 2 {
+			return nil, &DictionaryError{Line: i + 1,
+				Message: fmt.Sprintf("expected 2 columns, got %d", len(record))}
+		}
+		res = append(res, dictionaryEntry{record[0], record[1], i + 1})
+	}
+	return res, nil
+}
+
+// readDictionary reads and validates the entries of a dictionary: Keys must not be empty or appear more than
+// once and keys and values must be valid UTF-8.
+func readDictionary(r io.Reader, format DictionaryFormat) ([]dictionaryEntry, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	// spreadsheet applications often write a BOM
+	content = bytes.TrimPrefix(content, []byte("\ufeff"))
+	var entries []dictionaryEntry
+	switch format {
+	case DictionaryJSON:
+		entries, err = parseJSONDictionary(content)
+	case DictionaryYAML:
+		entries, err = parseYAMLDictionary(content)
+	case DictionaryCSV:
+		entries, err = parseCSVDictionary(content)
+	default:
+		return nil, fmt.Errorf("unknown dictionary format %d", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	lines := make(map[string]int, len(entries))
+	for _, entry := range entries {
+		switch {
+		case entry.key == "":
+			return nil, &DictionaryError{Line: entry.line, Message: "empty key"}
+		case !utf8.ValidString(entry.key) || !utf8.ValidString(entry.value):
+			return nil, &DictionaryError{Line: entry.line, Message: "invalid UTF-8"}
+		}
+		if line, has := lines[entry.key]; has {
+			return nil, &DictionaryError{Line: entry.line,
+				Message: fmt.Sprintf("duplicate key \"%s\" (first defined in line %d)", entry.key, line)}
+		}
+		lines[entry.key] = entry.line
+	}
+	return entries, nil
+}
+
+// LoadReplaceMap reads a StringReplaceMap from r in the given format, for example a list of words
+// maintained in a spreadsheet and exported as CSV.
+// A *DictionaryError is returned if the dictionary is not valid: Keys must not be empty or defined more than
+// once.
+func LoadReplaceMap(r io.Reader, format DictionaryFormat) (StringReplaceMap, error) {
+	entries, err := readDictionary(r, format)
+	if err != nil {
+		return nil, err
+	}
+	res := make(StringReplaceMap, len(entries))
+	for _, entry := range entries {
+		res[entry.key] = entry.value
+	}
+	return res, nil
+}
+
+// LoadRuneMap reads a rune map (for example letter expansions, see LetterReplaceMap) from r in the given
+// format, see LoadReplaceMap. Each key must consist of exactly one rune.
+// See LanguageRegistry.LoadLettersFS to register the letter expansions of a language.
+func LoadRuneMap(r io.Reader, format DictionaryFormat) (map[rune]string, error) {
+	entries, err := readDictionary(r, format)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[rune]string, len(entries))
+	for _, entry := range entries {
+		if utf8.RuneCountInString(entry.key) != 1 {
+			return nil, &DictionaryError{Line: entry.line,
+				Message: fmt.Sprintf("key \"%s\" is not a single rune", entry.key)}
+		}
+		r, _ := utf8.DecodeRuneInString(entry.key)
+		res[r] = entry.value
+	}
+	return res, nil
+}
+
+// loadDictionaryFS opens the file name in fsys and calls load with the format given by the extension of
+// name. The name is added to a *DictionaryError.
+func loadDictionaryFS(fsys fs.FS, name string, load func(r io.Reader, format DictionaryFormat) error) error {
+	format, err := DictionaryFormatFromName(name)
+	if err != nil {
+		return err
+	}
+	file, err := fsys.Open(name)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	err = load(file, format)
+	var dictErr *DictionaryError
+	if errors.As(err, &dictErr) {
+		dictErr.File = name
+	}
+	return err
+}
+
+// LoadReplaceMapFS loads a StringReplaceMap from the file name in fsys (for example an embed.FS), the
+// format is given by the extension of name (see DictionaryFormatFromName and LoadReplaceMap).
+func LoadReplaceMapFS(fsys fs.FS, name string) (StringReplaceMap, error) {
+	var res StringReplaceMap
+	err := loadDictionaryFS(fsys, name, func(r io.Reader, format DictionaryFormat) error {
+		var loadErr error
+		res, loadErr = LoadReplaceMap(r, format)
+		return loadErr
+	})
+	return res, err
+}
+
+// LoadRuneMapFS loads a rune map from the file name in fsys (for example an embed.FS), the format is given by
+// the extension of name (see DictionaryFormatFromName and LoadRuneMap).
+func LoadRuneMapFS(fsys fs.FS, name string) (map[rune]string, error) {
+	var res map[rune]string
+	err := loadDictionaryFS(fsys, name, func(r io.Reader, format DictionaryFormat) error {
+		var loadErr error
+		res, loadErr = LoadRuneMap(r, format)
+		return loadErr
+	})
+	return res, err
+}
+
+// splitFileName splits a file name into a directory (which can be used in os.DirFS) and the base name.
+func splitFileName(name string) (string, string) {
+	dir, base := filepath.Split(name)
+	if dir == "" {
+		dir = "."
+	}
+	return dir, base
+}
+
+// LoadReplaceMapFile loads a StringReplaceMap from a file, the format is given by the extension of name
+// (see DictionaryFormatFromName and LoadReplaceMap).
+func LoadReplaceMapFile(name string) (StringReplaceMap, error) {
+	dir, base := splitFileName(name)
+	res, err := LoadReplaceMapFS(os.DirFS(dir), base)
+	var dictErr *DictionaryError
+	if errors.As(err, &dictErr) {
+		dictErr.File = name
+	}
+	return res, err
+}
+
+// LoadRuneMapFile loads a rune map from a file, the format is given by the extension of name
+// (see DictionaryFormatFromName and LoadRuneMap).
+func LoadRuneMapFile(name string) (map[rune]string, error) {
+	dir, base := splitFileName(name)
+	res, err := LoadRuneMapFS(os.DirFS(dir), base)
+	var dictErr *DictionaryError
+	if errors.As(err, &dictErr) {
+		dictErr.File = name
+	}
+	return res, err
+}
+
+// LoadMapsFS loads the replace maps of languages from the files in dir of fsys (for example an embed.FS) and
+// adds them to the registry with ExtendMap, this way dictionaries can be shipped without recompiling.
+// The name of a file (without the extension) is the language, for example "de-AT.csv" or "en.json". All
+// files with an extension supported by DictionaryFormatFromName are loaded, other files are ignored.
+//
+// If a file is not valid an error is returned (a *DictionaryError if the content is not valid) and no map is
+// added to the registry.
+func (registry *LanguageRegistry) LoadMapsFS(fsys fs.FS, dir string) error {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return err
+	}
+	loaded := make(map[string]StringReplaceMap, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() {
+			continue
+		}
+		if _, formatErr := DictionaryFormatFromName(name); formatErr != nil {
+			continue
+		}
+		m, err := LoadReplaceMapFS(fsys, path.Join(dir, name))
+		if err != nil {
+			return err
+		}
+		language := strings.TrimSuffix(name, path.Ext(name))
+		loaded[language] = MergeStringReplaceMaps(m, loaded[language])
+	}
+	for language, m := range loaded {
+		registry.ExtendMap(language, m)
+	}
+	return nil
+}
+
+// LoadMapsDir loads the replace maps of languages from the files in a directory, see LoadMapsFS.
+func (registry *LanguageRegistry) LoadMapsDir(dir string) error {
+	err := registry.LoadMapsFS(os.DirFS(dir), ".")
+	var dictErr *DictionaryError
+	if errors.As(err, &dictErr) {
+		dictErr.File = filepath.Join(dir, filepath.FromSlash(dictErr.File))
+	}
+	return err
+}
+
+// LoadLettersFS loads the letter expansions of a language from the file name in fsys (see LoadRuneMapFS) and
+// adds them as the transliterator of the language, like the letter expansions of the built-in languages
+// (for example SwedishLetters). Upper case letters are added, see LetterReplaceMap.
+// An existing transliterator of the language is replaced. As all transliterators the letter expansions are
+// not used in Unicode slugs (see SlugConfig.AllowUnicode).
+func (registry *LanguageRegistry) LoadLettersFS(fsys fs.FS, language, name string) error {
+	letters, err := LoadRuneMapFS(fsys, name)
+	if err != nil {
+		return err
+	}
+	registry.AddTransliterator(language, letterTransliterator(letters))
+	return nil
+}
+
+// LoadLettersFile loads the letter expansions of a language from a file, see LoadLettersFS.
+func (registry *LanguageRegistry) LoadLettersFile(language, name string) error {
+	letters, err := LoadRuneMapFile(name)
+	if err != nil {
+		return err
+	}
+	registry.AddTransliterator(language, letterTransliterator(letters))
+	return nil
+}
@@ -0,0 +1,230 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goslugify
+
+import "strings"
+
+const (
+	emojiZWJ                = 0x200D
+	emojiVariationSelector  = 0xFE0F
+	emojiKeycap             = 0x20E3
+	emojiRegionalIndicatorA = 0x1F1E6
+	emojiRegionalIndicatorZ = 0x1F1FF
+	emojiTagFirst           = 0xE0020
+	emojiTagLast            = 0xE007F
+)
+
+// emojiSkinTones contains the CLDR names of the skin tone modifiers (Fitzpatrick types).
+var emojiSkinTones = map[rune]string{
+	0x1F3FB: "light skin tone",
+	0x1F3FC: "medium-light skin tone",
+	0x1F3FD: "medium skin tone",
+	0x1F3FE: "medium-dark skin tone",
+	0x1F3FF: "dark skin tone",
+}
+
+// EmojiMode describes how emoji are handled, see NewEmojiModifier.
+type EmojiMode int
+
+const (
+	// EmojiDrop removes all emoji.
+	EmojiDrop EmojiMode = iota
+	// EmojiName replaces emoji by their CLDR short name, for example "🚀" --> "rocket".
+	EmojiName
+	// EmojiKeep keeps emoji unchanged.
+	EmojiKeep
+)
+
+// EmojiTable contains the emoji (without the variation selector U+FE0F) and their CLDR short names
+// (lower case and without punctuation), for example "🇩🇪" --> "flag germany".
+//
+// This table is embedded in the package, changes to it are used by all modifiers created with
+// NewEmojiModifier afterwards.
+var EmojiTable = parseEmojiData(emojiData)
+
+func parseEmojiData(data string) StringReplaceMap {
+	res := make(StringReplaceMap)
+	for _, line := range strings.Split(data, "\n") {
+		split := strings.SplitN(line, " ", 2)
+		if len(split) != 2 {
+			continue
+		}
+		res[split[0]] = split[1]
+	}
+	for tone, name := range emojiSkinTones {
+		res[string(tone)] = name
+	}
+	return res
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= emojiRegionalIndicatorA && r <= emojiRegionalIndicatorZ
+}
+
+// isEmojiComponent returns true for the runes that are only used as part of an emoji sequence.
+func isEmojiComponent(r rune) bool {
+	_, isSkinTone := emojiSkinTones[r]
+	return isSkinTone || isRegionalIndicator(r) || r == emojiZWJ || r == emojiVariationSelector ||
+		r == emojiKeycap || (r >= emojiTagFirst && r <= emojiTagLast)
+}
+
+// emojiToken is a rune of the input together with the variation selectors and skin tone modifiers
+// following it, start and end are the positions in the input.
+type emojiToken struct {
+	r          rune
+	start, end int
+	tones      []rune
+}
+
+func tokenizeEmoji(runes []rune) []emojiToken {
+	tokens := make([]emojiToken, 0, len(runes))
+	for i, r := range runes {
+		_, isSkinTone := emojiSkinTones[r]
+		if len(tokens) > 0 && (r == emojiVariationSelector || isSkinTone) {
+			last := &tokens[len(tokens)-1]
+			last.end = i + 1
+			if isSkinTone {
+				last.tones = append(last.tones, r)
+			}
+			continue
+		}
+		tokens = append(tokens, emojiToken{r: r, start: i, end: i + 1})
+	}
+	return tokens
+}
+
+// emojiName returns the name of the emoji consisting of the given tokens, the names of the skin tones are
+// appended ("👍🏽" --> "thumbs up medium skin tone").
+func emojiName(name string, tokens []emojiToken) string {
+	var tones []string
+	for _, token := range tokens {
+		for _, tone := range token.tones {
+			toneName := emojiSkinTones[tone]
+			isNew := true
+			for _, existing := range tones {
+				if existing == toneName {
+					isNew = false
+					break
+				}
+			}
+			if isNew {
+				tones = append(tones, toneName)
+			}
+		}
+	}
+	if len(tones) > 0 {
+		name += " " + strings.Join(tones, " ")
+	}
+	return " " + name + " "
+}
+
+// NewEmojiModifier returns a StringModifierFunc that handles emoji according to mode.
+//
+// With EmojiName each emoji is replaced by its name from EmojiTable, the name is surrounded by spaces.
+// The default processors replace spaces by the word separator, thus "I ❤️ Go" becomes "i-red-heart-go".
+// The longest sequence wins, this way ZWJ sequences ("👩‍💻" --> "woman technologist"), keycaps and flags
+// ("🇩🇪" --> "flag germany") are handled.
+// Skin tone modifiers are appended to the name as in CLDR ("👍🏽" --> "thumbs up medium skin tone") and
+// flags that are not in EmojiTable are replaced by "flag" and the region code ("flag xx").
+//
+// With EmojiDrop all emoji in EmojiTable are removed and with EmojiKeep the string is not changed.
+// Note that the default processors drop emoji (see ValidSlugRuneReplaceFunc), use KeepEmoji to keep them.
+func NewEmojiModifier(mode EmojiMode) StringModifierFunc {
+	if mode == EmojiKeep {
+		return func(in string) string {
+			return in
+		}
+	}
+	table := make(map[string]string, len(EmojiTable))
+	starts := make(map[rune]bool)
+	maxLen := 0
+	for key, name := range EmojiTable {
+		runes := []rune(key)
+		if len(runes) == 0 {
+			continue
+		}
+		table[key] = name
+		starts[runes[0]] = true
+		if len(runes) > maxLen {
+			maxLen = len(runes)
+		}
+	}
+	replace := func(name string, tokens []emojiToken) string {
+		if mode == EmojiName {
+			return emojiName(name, tokens)
+		}
+		return ""
+	}
+
+	return func(in string) string {
+		runes := []rune(in)
+		tokens := tokenizeEmoji(runes)
+		var buf strings.Builder
+		for i := 0; i < len(tokens); {
+			token := tokens[i]
+			if !starts[token.r] && !isRegionalIndicator(token.r) {
+				buf.WriteString(string(runes[token.start:token.end]))
+				i++
+				continue
+			}
+			// find the longest sequence in the table
+			matched, matchedName := 0, ""
+			var key strings.Builder
+			for n := 1; n <= maxLen && i+n <= len(tokens); n++ {
+				key.WriteRune(tokens[i+n-1].r)
+				if name, has := table[key.String()]; has {
+					matched, matchedName = n, name
+				}
+			}
+			switch {
+			case matched > 0:
+				buf.WriteString(replace(matchedName, tokens[i:i+matched]))
+				i += matched
+			case i+1 < len(tokens) && isRegionalIndicator(token.r) && isRegionalIndicator(tokens[i+1].r):
+				region := string([]rune{'a' + token.r - emojiRegionalIndicatorA,
+					'a' + tokens[i+1].r - emojiRegionalIndicatorA})
+				buf.WriteString(replace("flag "+region, tokens[i:i+2]))
+				i += 2
+			default:
+				buf.WriteString(string(runes[token.start:token.end]))
+				i++
+			}
+		}
+		return buf.String()
+	}
+}
+
+// KeepEmoji is a RuneHandleFunc that accepts all runes that are part of an emoji (the emoji in EmojiTable
+// and the runes used in emoji sequences such as ZWJ, skin tone modifiers and regional indicators).
+// ASCII runes (used in keycaps) are not handled.
+//
+// It can be used to keep emoji when placed before ValidSlugRuneReplaceFunc.
+func KeepEmoji(r rune) (bool, string) {
+	if r >= 0x80 && (isEmojiComponent(r) || emojiRunes[r]) {
+		return true, string(r)
+	}
+	return false, ""
+}
+
+// emojiRunes contains all runes that appear in EmojiTable.
+var emojiRunes = func() map[rune]bool {
+	res := make(map[rune]bool)
+	for key := range EmojiTable {
+		for _, r := range key {
+			res[r] = true
+		}
+	}
+	return res
+}()
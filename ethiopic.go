@@ -0,0 +1,77 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goslugify
+
+const (
+	ethiopicFirst = 0x1200
+	ethiopicLast  = 0x135A
+)
+
+// ethiopicConsonants contains the consonant of each row of the Ethiopic syllabary (a row consists of
+// eight syllables, the first one of the row at U+1200 is "ሀ").
+var ethiopicConsonants = [...]string{
+	"h", "l", "h", "m", "s", "r", "s", "sh", "q", "q", "qh", "qh", "b", "v", "t", "ch",
+	"h", "h", "n", "ny", "", "k", "k", "kh", "kh", "w", "", "z", "zh", "y", "d", "dd",
+	"j", "g", "g", "gg", "t", "ch", "p", "ts", "ts", "f", "p",
+}
+
+// ethiopicVowels contains the vowels of the eight orders, the sixth order is usually pronounced without
+// vowel (or a short "ə") and thus is not written.
+var ethiopicVowels = [...]string{"e", "u", "i", "a", "e", "", "o", "wa"}
+
+// ethiopicLabialVowels contains the vowels of the labialized rows ("ቈ", "ኈ", "ኰ", "ዀ", "ጐ" etc.).
+var ethiopicLabialVowels = [...]string{"we", "", "wi", "wa", "we", "w", "", ""}
+
+// ethiopicLabialRows contains the rows that contain labialized consonants.
+var ethiopicLabialRows = map[int]bool{0x09: true, 0x0B: true, 0x11: true, 0x16: true, 0x18: true, 0x22: true}
+
+// ethiopicRowsFirstA contains the rows ("ሀ", "ሐ", "ኀ", "አ", "ዐ") where the first order is pronounced "a"
+// in Amharic.
+var ethiopicRowsFirstA = map[int]bool{0x00: true, 0x02: true, 0x10: true, 0x14: true, 0x1A: true}
+
+// ethiopicOthers contains syllables outside of the regular rows and punctuation.
+var ethiopicOthers = map[rune]string{
+	'ፘ': "mya", 'ፙ': "rya", 'ፚ': "fya",
+	'፡': " ", '።': ".", '፣': ",", '፤': ";", '፥': ":", '፦': ":", '፧': "?", '፨': "",
+}
+
+// TransliterateEthiopic is a RuneHandleFunc that transliterates the Ethiopic syllabary (Ge'ez script, used
+// for Amharic and Tigrinya) to Latin, for example "ሰላም" --> "selam".
+//
+// Each syllable consists of a consonant and one of seven vowels, the sixth order is written as the
+// consonant alone ("ም" --> "m"). The first order is "e" except for the glottal and "h" rows where it's "a"
+// ("አዲስ" --> "adis"). Ejective consonants are written like the plain ones without apostrophe.
+// The Ethiopic word space ("፡") becomes a space and punctuation is converted to ASCII.
+//
+// Ethiopic numerals are not handled as they can't be converted rune by rune.
+func TransliterateEthiopic(r rune) (bool, string) {
+	if res, has := ethiopicOthers[r]; has {
+		return true, res
+	}
+	if r < ethiopicFirst || r > ethiopicLast {
+		return false, ""
+	}
+	row, order := int(r-ethiopicFirst)/8, int(r-ethiopicFirst)%8
+	if row >= len(ethiopicConsonants) {
+		return false, ""
+	}
+	if ethiopicLabialRows[row] {
+		return true, ethiopicConsonants[row] + ethiopicLabialVowels[order]
+	}
+	if order == 0 && ethiopicRowsFirstA[row] {
+		return true, ethiopicConsonants[row] + "a"
+	}
+	return true, ethiopicConsonants[row] + ethiopicVowels[order]
+}
@@ -164,3 +164,180 @@ func ExampleGetLanguageMap() {
 	fmt.Println(generator.GenerateSlug("Aragorn & Arwen"))
 	// Output: aragorn-und-arwen
 }
+
+func ExampleTranslateDiacritics() {
+	modifier := goslugify.RuneHandleFuncToStringModifierFunc(goslugify.TranslateDiacritics)
+	fmt.Println(modifier("éåøł"))
+	// Output: eaol
+}
+
+func ExampleSlugConfig_AddLanguage() {
+	config := goslugify.NewSlugConfig()
+	config.AddLanguage("ru")
+	generator := config.Configure()
+	fmt.Println(generator.GenerateSlug("Юрий Гагарин & Сергей Королёв"))
+	// Output: yuriy-gagarin-i-sergey-korolev
+}
+
+func ExampleNewCyrillicTransliterator() {
+	transliterator := goslugify.NewCyrillicTransliterator(goslugify.CyrillicGOST779B, "ru")
+	fmt.Println(transliterator.Modify("Щука"))
+	// Output: Shhuka
+}
+
+func ExampleTransliterateGreek() {
+	config := goslugify.NewSlugConfig()
+	config.AddLanguage("el")
+	generator := config.Configure()
+	fmt.Println(generator.GenerateSlug("Κέρκυρα & Ζάκυνθος"))
+	// Output: kerkyra-kai-zakynthos
+}
+
+func ExampleNewPinyinTransliterator() {
+	transliterator := goslugify.NewPinyinTransliterator()
+	// add a reading for a character that is not in the embedded dictionary
+	transliterator.Table["犇"] = " ben "
+	config := goslugify.NewSlugConfig()
+	config.AddReplaceMap(goslugify.GetLanguageMap("zh"))
+	generator := config.Configure().WithProcessor(goslugify.ToStringHandleFunc(transliterator))
+	fmt.Println(generator.GenerateSlug("北京大学犇"))
+	// Output: bei-jing-da-xue-ben
+}
+
+func ExampleJapaneseTransliterator() {
+	dict := goslugify.NewKanjiMapDictionary(goslugify.StringReplaceMap{
+		"抹茶": "まっちゃ",
+	})
+	transliterator := goslugify.NewJapaneseTransliterator(dict)
+	generator := goslugify.NewDefaultSlugGenerator().WithProcessor(goslugify.ToStringHandleFunc(transliterator))
+	fmt.Println(generator.GenerateSlug("抹茶ラテ"))
+	// Output: matcha-rate
+}
+
+func ExampleTransliterateKorean() {
+	fmt.Println(goslugify.TransliterateKorean("한국어"))
+	fmt.Println(goslugify.TransliterateKorean("종로"))
+	// Output: hangugeo
+	// jongno
+}
+
+func ExampleTransliterateArabic() {
+	config := goslugify.NewSlugConfig()
+	config.AddLanguage("ar")
+	fmt.Println(config.Configure().GenerateSlug("القاهرة ٢٠٢٠"))
+	// Output: alqahrh-2020
+}
+
+func ExampleTransliterateHebrew() {
+	fmt.Println(goslugify.TransliterateHebrew("בֵּית סֵפֶר"))
+	// Output: bit sfr
+}
+
+func ExampleIndicTransliterator() {
+	config := goslugify.NewSlugConfig()
+	config.AddLanguage("hi")
+	fmt.Println(config.Configure().GenerateSlug("नमस्ते भारत"))
+	// Output: namaste-bharat
+}
+
+func ExampleTransliterateThai() {
+	fmt.Println(goslugify.TransliterateThai("เชียงใหม่"))
+	// Output: chiangmai
+}
+
+func ExampleNewArmenianTransliterator() {
+	fmt.Println(goslugify.NewArmenianTransliterator().Modify("Երևան"))
+	// Output: Yerevan
+}
+
+func ExampleTransliterateVietnamese() {
+	config := goslugify.NewSlugConfig()
+	config.AddLanguage("vi")
+	fmt.Println(config.Configure().GenerateSlug("Tiếng Việt"))
+	// Output: tieng-viet
+}
+
+func ExampleLetterReplaceMap() {
+	config := goslugify.NewSlugConfig()
+	config.AddReplaceMap(goslugify.LetterReplaceMap(goslugify.SwedishLetters))
+	fmt.Println(config.Configure().GenerateSlug("Malmö"))
+	// Output: malmo
+}
+
+func ExampleNewEmojiModifier() {
+	config := goslugify.NewSlugConfig()
+	config.Emoji = goslugify.EmojiName
+	fmt.Println(config.Configure().GenerateSlug("I ❤️ Go 🚀"))
+	// Output: i-red-heart-go-rocket
+}
+
+func ExampleNewSymbolReplaceMap() {
+	config := goslugify.NewSlugConfig()
+	config.AddLanguage("de")
+	fmt.Println(config.Configure().GenerateSlug("50% auf alles"))
+	// Output: 50-prozent-auf-alles
+}
+
+func ExampleNewUnicodeNameFunc() {
+	config := goslugify.NewSlugConfig()
+	config.UnicodeNames = true
+	fmt.Println(config.Configure().GenerateSlug("☃ Day"))
+	// Output: snowman-day
+}
+
+func ExampleParseTransformRules() {
+	transliterator, err := goslugify.ParseTransformRules(`
+		::NFD ;
+		$vowel = [aeiou] ;
+		$vowel { s } $vowel > z ;
+		::[:Mn:] Remove ;
+		::NFC ;
+	`, nil)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(transliterator.Modify("rosé"))
+	// Output: roze
+}
+
+func ExampleSlugConfig_allowUnicode() {
+	config := goslugify.NewSlugConfig()
+	config.AllowUnicode = true
+	fmt.Println(config.Configure().GenerateSlug("Привет, мир!"))
+	// Output: привет-мир
+}
+
+func ExampleSkeleton() {
+	// the first string contains the Cyrillic "р" and "а"
+	fmt.Println(goslugify.Skeleton("раypal") == goslugify.Skeleton("paypal"))
+	config := goslugify.NewSlugConfig()
+	config.AllowUnicode = true
+	config.Confusables = goslugify.ConfusableFold
+	fmt.Println(config.Configure().GenerateSlug("раypal"))
+	// Output: true
+	// paypal
+}
+
+func ExampleRemoveInvisibles() {
+	// the first string contains a zero width space, the second one a soft hyphen
+	fmt.Println(goslugify.GenerateSlug("foo\u200bbar"))
+	fmt.Println(goslugify.GenerateSlug("Silben\u00adtrennung"))
+	// Output: foo-bar
+	// silbentrennung
+}
+
+func ExampleNewLowerCaseFunc() {
+	toLower := goslugify.NewLowerCaseFunc(goslugify.LanguageTurkish)
+	fmt.Println(toLower("İSTANBUL IĞDIR"))
+	// Output: istanbul ığdır
+}
+
+func ExampleStopWordFilter() {
+	config := goslugify.NewSlugConfig()
+	config.RemoveStopWords = true
+	fmt.Println(config.Configure().GenerateSlug("The most interesting species of rodents"))
+	config.Language = goslugify.LanguageGerman
+	fmt.Println(config.Configure().GenerateSlug("Ein Herz für Kinder"))
+	// Output: most-interesting-species-rodents
+	// herz-kinder
+}
@@ -0,0 +1,39 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goslugify
+
+import "unicode"
+
+// georgianLetters contains the transliteration of the Mkhedruli alphabet according to the national
+// system of Georgia (2002) without the apostrophes that mark ejective consonants.
+var georgianLetters = map[rune]string{
+	'ა': "a", 'ბ': "b", 'გ': "g", 'დ': "d", 'ე': "e", 'ვ': "v", 'ზ': "z", 'თ': "t", 'ი': "i", 'კ': "k",
+	'ლ': "l", 'მ': "m", 'ნ': "n", 'ო': "o", 'პ': "p", 'ჟ': "zh", 'რ': "r", 'ს': "s", 'ტ': "t", 'უ': "u",
+	'ფ': "p", 'ქ': "k", 'ღ': "gh", 'ყ': "q", 'შ': "sh", 'ჩ': "ch", 'ც': "ts", 'ძ': "dz", 'წ': "ts",
+	'ჭ': "ch", 'ხ': "kh", 'ჯ': "j", 'ჰ': "h",
+	// archaic letters
+	'ჱ': "e", 'ჲ': "y", 'ჳ': "w", 'ჴ': "q", 'ჵ': "o", 'ჶ': "f", 'ჷ': "y", 'ჸ': "", 'ჹ': "", 'ჺ': "",
+}
+
+// TransliterateGeorgian is a RuneHandleFunc that transliterates Georgian according to the national system
+// (2002) without apostrophes, for example "თბილისი" --> "tbilisi".
+//
+// Mtavruli (capital letters) are handled like the corresponding Mkhedruli letters, the result is always
+// lower case.
+// All runes that are not Georgian letters are not handled.
+func TransliterateGeorgian(r rune) (bool, string) {
+	res, has := georgianLetters[unicode.ToLower(r)]
+	return has, res
+}
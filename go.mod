@@ -1,5 +1,8 @@
 module github.com/FabianWe/goslugify
 
-go 1.14
+go 1.16
 
-require golang.org/x/text v0.3.2
+require (
+	golang.org/x/text v0.3.2
+	gopkg.in/yaml.v3 v3.0.1
+)
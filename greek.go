@@ -0,0 +1,124 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goslugify
+
+import (
+	"golang.org/x/text/unicode/norm"
+	"strings"
+	"unicode"
+)
+
+// greekLetters contains the transliteration of single (lower case) Greek letters according to ELOT 743.
+var greekLetters = map[rune]string{
+	'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i", 'θ': "th",
+	'ι': "i", 'κ': "k", 'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o", 'π': "p",
+	'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t", 'υ': "y", 'φ': "f", 'χ': "ch", 'ψ': "ps",
+	'ω': "o",
+}
+
+// greekDigraphs contains the consonant combinations of ELOT 743 that are not transliterated
+// letter by letter.
+var greekDigraphs = map[string]string{
+	"γγ": "ng",
+	"γκ": "gk",
+	"γξ": "nx",
+	"γχ": "nch",
+}
+
+// greekVoiceless contains the voiceless consonants, "αυ", "ευ" and "ηυ" are transliterated to
+// "af", "ef" and "if" before them (and at the end of a word), otherwise to "av", "ev" and "iv".
+const greekVoiceless = "θκξπστφχψς"
+
+// greekRune is a Greek letter without tonos, diaeresis is true if the letter had a diaeresis.
+type greekRune struct {
+	base      rune
+	diaeresis bool
+}
+
+func decomposeGreek(r rune) greekRune {
+	r = unicode.ToLower(r)
+	if !unicode.Is(unicode.Greek, r) {
+		return greekRune{r, false}
+	}
+	res := greekRune{r, false}
+	for i, decomposed := range []rune(norm.NFD.String(string(r))) {
+		if i == 0 {
+			res.base = decomposed
+		} else if decomposed == '̈' {
+			res.diaeresis = true
+		}
+	}
+	return res
+}
+
+func isGreekWordEnd(runes []greekRune, pos int) bool {
+	return pos >= len(runes) || !isWordRune(runes[pos].base)
+}
+
+// TransliterateGreek is a StringModifierFunc that transliterates Greek to Latin according to ELOT 743
+// (the standard that is also used for Greek passports).
+//
+// Accents (tonos) are removed and the context rules of the standard are applied:
+// "αυ", "ευ" and "ηυ" become "av", "ev" and "iv" before vowels and voiced consonants and "af", "ef" and
+// "if" before voiceless consonants and at the end of a word, "ου" becomes "ou", "μπ" becomes "b" at the
+// beginning or the end of a word and "mb" otherwise, "γγ" becomes "ng" etc.
+// A diaeresis prevents these rules ("αϋ" becomes "ay").
+//
+// Runes that are not Greek letters are not changed.
+func TransliterateGreek(in string) string {
+	original := []rune(in)
+	runes := make([]greekRune, len(original))
+	for i, r := range original {
+		runes[i] = decomposeGreek(r)
+	}
+	var buf strings.Builder
+	for i := 0; i < len(runes); {
+		current := runes[i]
+		var next greekRune
+		if i+1 < len(runes) {
+			next = runes[i+1]
+		}
+		n, res := 1, ""
+		switch {
+		case next.base == 'υ' && !next.diaeresis && strings.ContainsRune("αεη", current.base):
+			n, res = 2, greekLetters[current.base]
+			if isGreekWordEnd(runes, i+2) || strings.ContainsRune(greekVoiceless, runes[i+2].base) {
+				res += "f"
+			} else {
+				res += "v"
+			}
+		case next.base == 'υ' && !next.diaeresis && current.base == 'ο':
+			n, res = 2, "ou"
+		case current.base == 'μ' && next.base == 'π':
+			n, res = 2, "mb"
+			if i == 0 || !isWordRune(runes[i-1].base) || isGreekWordEnd(runes, i+2) {
+				res = "b"
+			}
+		default:
+			if digraph, has := greekDigraphs[string([]rune{current.base, next.base})]; has {
+				n, res = 2, digraph
+			} else if letter, has := greekLetters[current.base]; has {
+				res = letter
+			} else {
+				buf.WriteRune(original[i])
+				i++
+				continue
+			}
+		}
+		buf.WriteString(applyCase(original, i, i+n, res))
+		i += n
+	}
+	return buf.String()
+}
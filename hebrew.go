@@ -0,0 +1,167 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goslugify
+
+import "strings"
+
+const (
+	hebrewDagesh  = 0x05BC
+	hebrewShinDot = 0x05C1
+	hebrewSinDot  = 0x05C2
+	hebrewGeresh  = 0x05F3
+)
+
+// hebrewLetters contains the romanization of the Hebrew consonants (simplified rules of the Academy of the
+// Hebrew Language, 2006).
+// The letters "ב", "כ" and "פ" are handled in TransliterateHebrew because they depend on the dagesh.
+var hebrewLetters = map[rune]string{
+	'א': "", 'ג': "g", 'ד': "d", 'ה': "h", 'ז': "z", 'ח': "h", 'ט': "t", 'ל': "l", 'מ': "m", 'ם': "m",
+	'נ': "n", 'ן': "n", 'ס': "s", 'ע': "", 'צ': "ts", 'ץ': "ts", 'ק': "k", 'ר': "r", 'ת': "t",
+	'װ': "v", 'ױ': "oy", 'ײ': "ey",
+}
+
+// hebrewGereshLetters contains the letters that are used with a geresh for sounds not native to Hebrew.
+var hebrewGereshLetters = map[rune]string{
+	'ג': "j", 'ז': "zh", 'צ': "ch", 'ץ': "ch", 'ת': "th",
+}
+
+// hebrewPunctuation contains the Hebrew punctuation, gershayim (used in acronyms) is dropped.
+var hebrewPunctuation = map[rune]string{
+	'־': "-", '׀': "", '׃': ".", '׆': "", '״': "", hebrewGeresh: "",
+}
+
+func isHebrewLetter(r rune) bool {
+	return r >= 'א' && r <= 'ײ'
+}
+
+// isHebrewMark returns true for niqqud (vowel points, dagesh, shin / sin dot) and cantillation marks.
+func isHebrewMark(r rune) bool {
+	return (r >= 0x0591 && r <= 0x05BD) || r == 0x05BF || r == hebrewShinDot || r == hebrewSinDot ||
+		r == 0x05C4 || r == 0x05C5 || r == 0x05C7
+}
+
+// isHebrewVowelPoint returns true for the vowel points (except holam which is a vowel on "ו").
+func isHebrewVowelPoint(r rune) bool {
+	return (r >= 0x05B0 && r <= 0x05BB && r != 0x05B9 && r != 0x05BA) || r == 0x05C7
+}
+
+// hebrewLetter is a letter together with the relevant niqqud following it.
+type hebrewLetter struct {
+	dagesh, sinDot, vowel, geresh bool
+}
+
+// TransliterateHebrew is a StringModifierFunc that romanizes Hebrew according to the simplified rules of the
+// Academy of the Hebrew Language (2006), consonants only.
+//
+// Niqqud and cantillation marks are removed, before that the following marks are used: A dagesh
+// distinguishes "b" / "v", "k" / "kh" and "p" / "f", the sin dot gives "s" instead of "sh" and a letter
+// with a geresh is romanized as a foreign sound (for example "ג׳" --> "j").
+// In texts without niqqud "ב", "כ" and "פ" are romanized as "b", "k" and "p" at the beginning of a word and
+// as "v", "kh" and "f" otherwise.
+// "ו" and "י" are romanized as "v" and "y" at the beginning of a word, if they're doubled or carry a
+// vowel point, otherwise they're read as vowels ("o" or "u" with shuruk and "i").
+// "א" and "ע" are silent and final forms are handled ("ך" --> "kh", "ף" --> "f", "ץ" --> "ts").
+// Note that short vowels are not written, thus "שָׁלוֹם" becomes "shlom".
+//
+// All runes that are not Hebrew are not changed.
+func TransliterateHebrew(in string) string {
+	runes := []rune(in)
+	var buf strings.Builder
+	wordStart := true
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if !isHebrewLetter(r) {
+			if isHebrewMark(r) {
+				continue
+			}
+			if res, has := hebrewPunctuation[r]; has {
+				buf.WriteString(res)
+			} else {
+				buf.WriteRune(r)
+			}
+			wordStart = true
+			continue
+		}
+		// collect the marks following the letter
+		var letter hebrewLetter
+		next := i + 1
+		for ; next < len(runes) && (isHebrewMark(runes[next]) || runes[next] == hebrewGeresh); next++ {
+			switch mark := runes[next]; {
+			case mark == hebrewDagesh:
+				letter.dagesh = true
+			case mark == hebrewSinDot:
+				letter.sinDot = true
+			case mark == hebrewGeresh:
+				letter.geresh = true
+			case isHebrewVowelPoint(mark):
+				letter.vowel = true
+			}
+		}
+		doubled := next < len(runes) && runes[next] == r && (r == 'ו' || r == 'י')
+		hard := letter.dagesh || wordStart
+		var res string
+		switch {
+		case letter.geresh && hebrewGereshLetters[r] != "":
+			res = hebrewGereshLetters[r]
+		case r == 'ב':
+			res = "v"
+			if hard {
+				res = "b"
+			}
+		case r == 'כ':
+			res = "kh"
+			if hard {
+				res = "k"
+			}
+		case r == 'ך':
+			res = "kh"
+		case r == 'פ':
+			res = "f"
+			if hard {
+				res = "p"
+			}
+		case r == 'ף':
+			res = "f"
+		case r == 'ש':
+			res = "sh"
+			if letter.sinDot {
+				res = "s"
+			}
+		case r == 'ו':
+			switch {
+			case wordStart || doubled || letter.vowel:
+				res = "v"
+			case letter.dagesh:
+				res = "u"
+			default:
+				res = "o"
+			}
+		case r == 'י':
+			res = "i"
+			if wordStart || doubled || letter.vowel {
+				res = "y"
+			}
+		default:
+			res = hebrewLetters[r]
+		}
+		buf.WriteString(res)
+		if doubled {
+			next++
+		}
+		i = next - 1
+		wordStart = false
+	}
+	return buf.String()
+}
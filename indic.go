@@ -0,0 +1,268 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goslugify
+
+import "strings"
+
+// The Brahmic scripts in Unicode share the same layout: Each script has a block of 128 code points and
+// letters with the same offset in the block have the same (or a similar) sound.
+// Thus the tables below contain offsets, they're valid for all scripts from Devanagari (U+0900) to
+// Malayalam (U+0D7F).
+const (
+	indicFirst     = 0x0900
+	indicLast      = 0x0D7F
+	indicBlockSize = 0x80
+
+	indicCandrabindu = 0x01
+	indicAnusvara    = 0x02
+	indicNukta       = 0x3C
+	indicVirama      = 0x4D
+)
+
+// indices of the scripts (blocks)
+const (
+	indicDevanagari = iota
+	indicBengali
+	indicGurmukhi
+	indicGujarati
+	indicOriya
+	indicTamil
+	indicTelugu
+	indicKannada
+	indicMalayalam
+)
+
+// indicConsonants contains the consonants (ISO 15919 without diacritics).
+var indicConsonants = map[rune]string{
+	0x15: "k", 0x16: "kh", 0x17: "g", 0x18: "gh", 0x19: "n",
+	0x1A: "c", 0x1B: "ch", 0x1C: "j", 0x1D: "jh", 0x1E: "n",
+	0x1F: "t", 0x20: "th", 0x21: "d", 0x22: "dh", 0x23: "n",
+	0x24: "t", 0x25: "th", 0x26: "d", 0x27: "dh", 0x28: "n", 0x29: "n",
+	0x2A: "p", 0x2B: "ph", 0x2C: "b", 0x2D: "bh", 0x2E: "m",
+	0x2F: "y", 0x30: "r", 0x31: "r", 0x32: "l", 0x33: "l", 0x34: "l", 0x35: "v",
+	0x36: "s", 0x37: "s", 0x38: "s", 0x39: "h",
+	// consonants with nukta (precomposed)
+	0x58: "q", 0x59: "kh", 0x5A: "g", 0x5B: "z", 0x5C: "r", 0x5D: "rh", 0x5E: "f", 0x5F: "y",
+}
+
+// indicNuktaConsonants contains the consonants that change their sound with a nukta.
+// NFKC decomposes the precomposed consonants with nukta, so this is the usual form after pre-processing.
+var indicNuktaConsonants = map[rune]string{
+	0x15: "q", 0x16: "kh", 0x17: "g", 0x1C: "z", 0x21: "r", 0x22: "rh", 0x2B: "f", 0x2F: "y",
+}
+
+// indicVowelSigns contains the dependent vowel signs (matras).
+var indicVowelSigns = map[rune]string{
+	0x3A: "o", 0x3B: "e", 0x3E: "a", 0x3F: "i", 0x40: "i", 0x41: "u", 0x42: "u", 0x43: "r", 0x44: "r",
+	0x45: "e", 0x46: "e", 0x47: "e", 0x48: "ai", 0x49: "o", 0x4A: "o", 0x4B: "o", 0x4C: "au", 0x4F: "aw",
+	0x55: "", 0x56: "", 0x57: "", 0x62: "l", 0x63: "l",
+}
+
+// indicOthers contains independent vowels and other signs.
+var indicOthers = map[rune]string{
+	0x00: "", indicCandrabindu: "n", 0x03: "h",
+	0x04: "a", 0x05: "a", 0x06: "a", 0x07: "i", 0x08: "i", 0x09: "u", 0x0A: "u", 0x0B: "r", 0x0C: "l",
+	0x0D: "e", 0x0E: "e", 0x0F: "e", 0x10: "ai", 0x11: "o", 0x12: "o", 0x13: "o", 0x14: "au",
+	0x3D: "", 0x50: "om", 0x51: "", 0x52: "", 0x53: "", 0x54: "", 0x60: "r", 0x61: "l",
+	0x64: ".", 0x65: ".", 0x70: "", 0x71: "",
+}
+
+// indicScriptOverrides contains the offsets for which a script differs from Devanagari.
+var indicScriptOverrides = map[int]map[rune]string{
+	indicBengali:   {0x4E: "t"},
+	indicGurmukhi:  {0x70: "n", 0x72: "", 0x73: "", 0x75: ""},
+	indicTelugu:    {0x58: "ts", 0x59: "dz", 0x5A: "r"},
+	indicKannada:   {0x5E: "l"},
+	indicMalayalam: {0x4E: "r", 0x57: "au", 0x7A: "n", 0x7B: "n", 0x7C: "r", 0x7D: "l", 0x7E: "l", 0x7F: "k"},
+}
+
+// indicRune is a rune of a Brahmic script, split into the script index and the offset in the block.
+type indicRune struct {
+	script int
+	offset rune
+}
+
+func decomposeIndic(r rune) (indicRune, bool) {
+	if r < indicFirst || r > indicLast {
+		return indicRune{}, false
+	}
+	return indicRune{int((r - indicFirst) / indicBlockSize), (r - indicFirst) % indicBlockSize}, true
+}
+
+func (r indicRune) isConsonant() bool {
+	_, has := indicConsonants[r.offset]
+	return has
+}
+
+// lookup returns the entry of the rune in table, the script overrides take precedence.
+func (r indicRune) lookup(table map[rune]string) (string, bool) {
+	if res, has := indicScriptOverrides[r.script][r.offset]; has {
+		return res, true
+	}
+	res, has := table[r.offset]
+	return res, has
+}
+
+// vowelSign returns the romanization of the rune if it is a dependent vowel sign, the script overrides take
+// precedence.
+func (r indicRune) vowelSign() (string, bool) {
+	if _, has := indicVowelSigns[r.offset]; !has {
+		return "", false
+	}
+	return r.lookup(indicVowelSigns)
+}
+
+// isWordRune returns true if the rune belongs to a word (letters and signs, not punctuation or digits).
+func (r indicRune) isWordRune() bool {
+	return r.offset < 0x64 || r.offset >= 0x70
+}
+
+// IndicTransliterator romanizes the Brahmic scripts Devanagari (Hindi, Marathi, Nepali, Sanskrit), Bengali,
+// Gurmukhi, Gujarati, Oriya, Tamil, Telugu, Kannada and Malayalam according to ISO 15919 with the
+// diacritics removed (like IAST-to-ASCII), for example "हिन्दी" --> "hindi" and "தமிழ்" --> "tamil".
+//
+// A consonant without vowel sign gets the inherent vowel "a", the virama removes it.
+// A nukta changes the sound of some consonants ("ज़" --> "z", "फ़" --> "f", "ड़" --> "r").
+// Anusvara is romanized according to the following consonant: "ng" before velar consonants, "m" before labial
+// consonants (and at the end of a word in Telugu, Kannada and Malayalam) and "n" otherwise ("मुंबई" -->
+// "mumbai", "हिंदी" --> "hindi"), in Bengali it's always "ng" ("বাংলা" --> "bangla"). Visarga is romanized as
+// "h".
+// Native digits are mapped to ASCII digits.
+//
+// If DeleteFinalSchwa is true the inherent vowel of the last consonant of a word (with more than one
+// syllable) is not written, as it's not pronounced in modern Hindi, Marathi, Nepali and Bengali
+// ("भारत" --> "bharat" instead of "bharata").
+//
+// All runes that are not part of these scripts are not changed.
+type IndicTransliterator struct {
+	DeleteFinalSchwa bool
+}
+
+// NewIndicTransliterator returns a new IndicTransliterator.
+func NewIndicTransliterator(deleteFinalSchwa bool) *IndicTransliterator {
+	return &IndicTransliterator{DeleteFinalSchwa: deleteFinalSchwa}
+}
+
+// Modify implements the StringModifier interface.
+func (transliterator *IndicTransliterator) Modify(in string) string {
+	runes := []rune(in)
+	decomposed := make([]indicRune, len(runes))
+	isIndic := make([]bool, len(runes))
+	for i, r := range runes {
+		decomposed[i], isIndic[i] = decomposeIndic(r)
+	}
+	// returns true if the rune at position i is part of the same word as the rune before
+	inWord := func(i int) bool {
+		return i < len(runes) && isIndic[i] && decomposed[i].isWordRune()
+	}
+	var buf strings.Builder
+	syllables := 0
+	// true if the last consonant was followed by a virama (so the next one is part of a cluster)
+	cluster := false
+	for i := 0; i < len(runes); i++ {
+		if !isIndic[i] {
+			buf.WriteRune(runes[i])
+			syllables = 0
+			continue
+		}
+		current := decomposed[i]
+		switch {
+		case current.offset >= 0x66 && current.offset <= 0x6F:
+			buf.WriteRune('0' + current.offset - 0x66)
+			syllables = 0
+		case current.isConsonant():
+			consonant, _ := current.lookup(indicConsonants)
+			if inWord(i+1) && decomposed[i+1].offset == indicNukta {
+				if res, has := indicNuktaConsonants[current.offset]; has {
+					consonant = res
+				}
+				i++
+			}
+			buf.WriteString(consonant)
+			syllables++
+			inCluster := cluster
+			cluster = false
+			if !inWord(i + 1) {
+				if !transliterator.DeleteFinalSchwa || syllables == 1 || inCluster {
+					buf.WriteString("a")
+				}
+				break
+			}
+			next := decomposed[i+1]
+			if next.offset == indicVirama {
+				cluster = true
+				i++
+			} else if vowel, has := next.vowelSign(); has {
+				buf.WriteString(vowel)
+				i++
+			} else {
+				buf.WriteString("a")
+			}
+		case current.offset == indicAnusvara:
+			var next indicRune
+			if i+1 < len(runes) {
+				next = decomposed[i+1]
+			}
+			buf.WriteString(romanizeAnusvara(current, next, inWord(i+1)))
+		case current.offset == indicNukta || current.offset == indicVirama:
+			// nukta or virama without consonant, ignore
+		default:
+			if res, has := current.lookup(indicOthers); has {
+				buf.WriteString(res)
+				syllables++
+			} else if res, has := current.vowelSign(); has {
+				buf.WriteString(res)
+			} else {
+				buf.WriteRune(runes[i])
+			}
+			cluster = false
+			if !current.isWordRune() {
+				syllables = 0
+			}
+		}
+	}
+	return buf.String()
+}
+
+// romanizeAnusvara returns the romanization of the anusvara r, next is the following rune and nextInWord is
+// true if it is part of the same word.
+//
+// Like in ISO 15919 the anusvara takes the place of articulation of the following consonant: "ng" before
+// velar consonants, "m" before labial consonants and "n" otherwise. Before a consonant that is already
+// written with "g" it's just "n" ("गंगा" --> "ganga"). In Bengali it's always pronounced "ng".
+func romanizeAnusvara(r, next indicRune, nextInWord bool) string {
+	var consonant string
+	if nextInWord && next.isConsonant() {
+		consonant, _ = next.lookup(indicConsonants)
+	}
+	switch {
+	case !nextInWord && r.script >= indicTelugu:
+		// at the end of a word anusvara is pronounced "m" in the Dravidian languages
+		return "m"
+	case consonant == "":
+		if r.script == indicBengali {
+			return "ng"
+		}
+		return "n"
+	case strings.HasPrefix(consonant, "g"):
+		return "n"
+	case r.script == indicBengali, next.offset >= 0x15 && next.offset <= 0x19:
+		return "ng"
+	case next.offset >= 0x2A && next.offset <= 0x2E:
+		return "m"
+	default:
+		return "n"
+	}
+}
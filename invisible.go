@@ -0,0 +1,78 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goslugify
+
+import (
+	"strings"
+	"unicode"
+)
+
+// invisibleSeparators contains the invisible runes that separate words, they're replaced by a space.
+// All other default ignorable runes (soft hyphen, word joiner, BOM, ZWJ, ZWNJ etc.) are used inside words.
+var invisibleSeparators = map[rune]bool{
+	0x200B: true, // zero width space
+}
+
+// IsDefaultIgnorable returns true if r is a default ignorable code point as defined in the Unicode Standard
+// (the derived property Default_Ignorable_Code_Point): Runes that have no visible glyph, for example the soft
+// hyphen, zero width space, ZWJ and ZWNJ, the BOM, variation selectors and bidi controls (LRM, RLM, the
+// embeddings, overrides and isolates).
+func IsDefaultIgnorable(r rune) bool {
+	switch {
+	case unicode.In(r, unicode.Other_Default_Ignorable_Code_Point, unicode.Variation_Selector):
+		return true
+	case !unicode.Is(unicode.Cf, r):
+		return false
+	case unicode.In(r, unicode.White_Space, unicode.Prepended_Concatenation_Mark):
+		return false
+	case (r >= 0xFFF9 && r <= 0xFFFB) || (r >= 0x13430 && r <= 0x1343F):
+		// interlinear annotation and Egyptian hieroglyph format controls
+		return false
+	default:
+		return true
+	}
+}
+
+// isEmojiSequenceRune returns true if r can be followed by an emoji component (see isEmojiComponent).
+func isEmojiSequenceRune(r rune) bool {
+	return emojiRunes[r] || isEmojiComponent(r)
+}
+
+// RemoveInvisibles is a StringModifierFunc that removes all default ignorable runes (see IsDefaultIgnorable)
+// that are often contained in text pasted from word processors or chat tools.
+// The zero width space separates words and is replaced by a space, thus "foo​bar" becomes "foo bar".
+// All other runes are used inside words and are removed, so "Silben­trennung" (with a soft hyphen)
+// becomes "Silbentrennung" and not "Silben-trennung".
+//
+// ZWJ, variation selectors and tags that are part of an emoji sequence (they follow an emoji) are kept, so
+// emoji like "👩‍💻" are not split.
+//
+// It is usually called right after IgnoreInvalidUTF8.
+func RemoveInvisibles(in string) string {
+	var buf strings.Builder
+	var last rune
+	for _, r := range in {
+		switch {
+		case invisibleSeparators[r]:
+			buf.WriteRune(' ')
+		case !IsDefaultIgnorable(r), isEmojiComponent(r) && isEmojiSequenceRune(last):
+			buf.WriteRune(r)
+		default:
+			continue
+		}
+		last = r
+	}
+	return buf.String()
+}
@@ -0,0 +1,293 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goslugify
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// hiraganaRomaji contains the modified Hepburn romanization of all single hiragana.
+var hiraganaRomaji = map[rune]string{
+	'あ': "a", 'い': "i", 'う': "u", 'え': "e", 'お': "o",
+	'か': "ka", 'き': "ki", 'く': "ku", 'け': "ke", 'こ': "ko",
+	'が': "ga", 'ぎ': "gi", 'ぐ': "gu", 'げ': "ge", 'ご': "go",
+	'さ': "sa", 'し': "shi", 'す': "su", 'せ': "se", 'そ': "so",
+	'ざ': "za", 'じ': "ji", 'ず': "zu", 'ぜ': "ze", 'ぞ': "zo",
+	'た': "ta", 'ち': "chi", 'つ': "tsu", 'て': "te", 'と': "to",
+	'だ': "da", 'ぢ': "ji", 'づ': "zu", 'で': "de", 'ど': "do",
+	'な': "na", 'に': "ni", 'ぬ': "nu", 'ね': "ne", 'の': "no",
+	'は': "ha", 'ひ': "hi", 'ふ': "fu", 'へ': "he", 'ほ': "ho",
+	'ば': "ba", 'び': "bi", 'ぶ': "bu", 'べ': "be", 'ぼ': "bo",
+	'ぱ': "pa", 'ぴ': "pi", 'ぷ': "pu", 'ぺ': "pe", 'ぽ': "po",
+	'ま': "ma", 'み': "mi", 'む': "mu", 'め': "me", 'も': "mo",
+	'や': "ya", 'ゆ': "yu", 'よ': "yo",
+	'ら': "ra", 'り': "ri", 'る': "ru", 'れ': "re", 'ろ': "ro",
+	'わ': "wa", 'ゐ': "i", 'ゑ': "e", 'を': "o",
+	'ん': "n", 'ゔ': "vu",
+	'ぁ': "a", 'ぃ': "i", 'ぅ': "u", 'ぇ': "e", 'ぉ': "o",
+	'ゃ': "ya", 'ゅ': "yu", 'ょ': "yo", 'ゎ': "wa", 'ゕ': "ka", 'ゖ': "ke",
+}
+
+// kanaSmallVowelCombinations contains combinations with small vowels that are mostly used
+// in katakana for foreign words, for example "ティ" --> "ti".
+var kanaSmallVowelCombinations = map[string]string{
+	"ふぁ": "fa", "ふぃ": "fi", "ふぇ": "fe", "ふぉ": "fo", "ふゅ": "fyu",
+	"てぃ": "ti", "でぃ": "di", "とぅ": "tu", "どぅ": "du", "てゅ": "tyu", "でゅ": "dyu",
+	"うぃ": "wi", "うぇ": "we", "うぉ": "wo",
+	"しぇ": "she", "じぇ": "je", "ちぇ": "che", "いぇ": "ye",
+	"つぁ": "tsa", "つぃ": "tsi", "つぇ": "tse", "つぉ": "tso",
+	"ゔぁ": "va", "ゔぃ": "vi", "ゔぇ": "ve", "ゔぉ": "vo", "ゔゅ": "vyu",
+	"くぁ": "kwa", "ぐぁ": "gwa",
+}
+
+// macronVowels maps a vowel to the vowel with macron, used for long vowels.
+var macronVowels = map[byte]string{
+	'a': "ā", 'i': "ī", 'u': "ū", 'e': "ē", 'o': "ō",
+}
+
+// japanesePunctuation contains Japanese punctuation that separates words.
+const japanesePunctuation = "、。・「」『』（）〈〉《》【】〔〕〜"
+
+func katakanaToHiragana(r rune) rune {
+	if r >= 'ァ' && r <= 'ヶ' {
+		return r - 0x60
+	}
+	return r
+}
+
+func isKana(r rune) bool {
+	_, has := hiraganaRomaji[katakanaToHiragana(r)]
+	return has || r == 'っ' || r == 'ッ' || r == 'ー'
+}
+
+// KanjiDictionary is used by JapaneseTransliterator to look up the readings of kanji.
+type KanjiDictionary interface {
+	// LookupPrefix returns the reading (in hiragana or katakana) of the longest prefix of s that
+	// is contained in the dictionary together with the number of runes of this prefix.
+	// If no prefix is contained in the dictionary n must be 0.
+	LookupPrefix(s []rune) (reading string, n int)
+}
+
+// KanjiMapDictionary is a KanjiDictionary backed by a map from words to their reading.
+type KanjiMapDictionary struct {
+	Words     StringReplaceMap
+	maxKeyLen int
+}
+
+// NewKanjiMapDictionary returns a new dictionary given the words and their readings
+// (in hiragana or katakana).
+// The map must not be changed after the dictionary has been created.
+func NewKanjiMapDictionary(words StringReplaceMap) *KanjiMapDictionary {
+	maxKeyLen := 0
+	for key := range words {
+		if l := utf8.RuneCountInString(key); l > maxKeyLen {
+			maxKeyLen = l
+		}
+	}
+	return &KanjiMapDictionary{
+		Words:     words,
+		maxKeyLen: maxKeyLen,
+	}
+}
+
+// LookupPrefix returns the reading of the longest prefix of s in the dictionary.
+func (dict *KanjiMapDictionary) LookupPrefix(s []rune) (string, int) {
+	maxLen := dict.maxKeyLen
+	if len(s) < maxLen {
+		maxLen = len(s)
+	}
+	for l := maxLen; l > 0; l-- {
+		if reading, has := dict.Words[string(s[:l])]; has {
+			return reading, l
+		}
+	}
+	return "", 0
+}
+
+// JapaneseTransliterator is a StringModifier that romanizes Japanese according to modified Hepburn.
+//
+// Hiragana and katakana are fully supported: Long vowels are written with macrons ("とうきょう" --> "tōkyō",
+// "ー" lengthens the previous vowel), the sokuon "っ" doubles the following consonant ("まっちゃ" --> "matcha"),
+// "ん" is written as "n'" before vowels and "y" and combinations with small kana ("きゃ", "ティ") are
+// supported.
+// The default processors remove the macrons later, see TranslateDiacritics.
+//
+// Kanji can only be romanized with the help of a KanjiDictionary. Words from the dictionary are surrounded by
+// spaces (and thus become separate words in the slug). Kanji that are not found in the dictionary are kept
+// unchanged (and dropped by the default processors). Kanji is allowed to be nil.
+type JapaneseTransliterator struct {
+	Kanji KanjiDictionary
+}
+
+// NewJapaneseTransliterator returns a new transliterator given the kanji dictionary (which may be nil).
+func NewJapaneseTransliterator(kanji KanjiDictionary) *JapaneseTransliterator {
+	return &JapaneseTransliterator{
+		Kanji: kanji,
+	}
+}
+
+// lengthenLastVowel replaces the last byte in buf (which must be the vowel) by the vowel with macron.
+func lengthenLastVowel(buf *strings.Builder, vowel byte) {
+	macron, has := macronVowels[vowel]
+	if !has {
+		return
+	}
+	current := buf.String()
+	buf.Reset()
+	buf.WriteString(current[:len(current)-1])
+	buf.WriteString(macron)
+}
+
+// romanizeKana romanizes a sequence of kana (converted to hiragana).
+func romanizeKana(kana []rune) string {
+	// first compute all syllables, then handle sokuon, long vowels and "ん"
+	syllables := make([]string, 0, len(kana))
+	for i := 0; i < len(kana); i++ {
+		r := kana[i]
+		if r == 'っ' || r == 'ー' {
+			syllables = append(syllables, string(r))
+			continue
+		}
+		syllable := hiraganaRomaji[r]
+		if i+1 < len(kana) {
+			next := kana[i+1]
+			if combination, has := kanaSmallVowelCombinations[string([]rune{r, next})]; has {
+				syllables = append(syllables, combination)
+				i++
+				continue
+			}
+			if (next == 'ゃ' || next == 'ゅ' || next == 'ょ') && len(syllable) > 1 && strings.HasSuffix(syllable, "i") {
+				vowel := hiraganaRomaji[next][1:]
+				switch consonant := syllable[:len(syllable)-1]; consonant {
+				case "sh", "ch", "j":
+					syllable = consonant + vowel
+				default:
+					syllable = consonant + "y" + vowel
+				}
+				i++
+			}
+		}
+		syllables = append(syllables, syllable)
+	}
+
+	var buf strings.Builder
+	lastVowel := byte(0)
+	for i, syllable := range syllables {
+		var next string
+		if i+1 < len(syllables) {
+			next = syllables[i+1]
+		}
+		switch {
+		case syllable == "っ":
+			// double the consonant of the next syllable, "ch" becomes "tch"
+			if strings.HasPrefix(next, "ch") {
+				buf.WriteByte('t')
+			} else if next != "" && next[0] < utf8.RuneSelf && !strings.ContainsRune("aiueo", rune(next[0])) {
+				buf.WriteByte(next[0])
+			}
+			lastVowel = 0
+			continue
+		case syllable == "ー":
+			if lastVowel != 0 {
+				lengthenLastVowel(&buf, lastVowel)
+			}
+			lastVowel = 0
+			continue
+		case syllable == "n":
+			buf.WriteString("n")
+			if next != "" && strings.ContainsAny(next[:1], "aiueoy") {
+				buf.WriteByte('\'')
+			}
+			lastVowel = 0
+			continue
+		}
+		// long vowels: "aa", "uu", "ee", "oo" and "ou" are written with a macron
+		if len(syllable) == 1 && (syllable[0] == lastVowel || (lastVowel == 'o' && syllable == "u")) &&
+			lastVowel != 'i' {
+			lengthenLastVowel(&buf, lastVowel)
+			lastVowel = 0
+			continue
+		}
+		buf.WriteString(syllable)
+		lastVowel = syllable[len(syllable)-1]
+	}
+	return buf.String()
+}
+
+// Modify romanizes the string.
+func (t *JapaneseTransliterator) Modify(in string) string {
+	runes := []rune(in)
+	var buf strings.Builder
+	var kana []rune
+	flushKana := func() {
+		if len(kana) > 0 {
+			buf.WriteString(romanizeKana(kana))
+			kana = kana[:0]
+		}
+	}
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		if isKana(r) {
+			kana = append(kana, katakanaToHiragana(r))
+			i++
+			continue
+		}
+		flushKana()
+		if t.Kanji != nil && unicode.Is(unicode.Han, r) {
+			if reading, n := t.Kanji.LookupPrefix(runes[i:]); n > 0 {
+				buf.WriteByte(' ')
+				buf.WriteString(t.Modify(reading))
+				buf.WriteByte(' ')
+				i += n
+				continue
+			}
+		}
+		if strings.ContainsRune(japanesePunctuation, r) {
+			buf.WriteByte(' ')
+		} else {
+			buf.WriteRune(r)
+		}
+		i++
+	}
+	flushKana()
+	return buf.String()
+}
+
+// DefaultKanjiDictionary is a small dictionary of common Japanese words and place names, it is used for the
+// language "ja".
+// It is by no means complete, if you need to romanize kanji you should provide your own KanjiDictionary.
+var DefaultKanjiDictionary = NewKanjiMapDictionary(StringReplaceMap{
+	"日本": "にほん", "日本語": "にほんご", "日本人": "にほんじん", "東京": "とうきょう", "東京都": "とうきょうと",
+	"大阪": "おおさか", "京都": "きょうと", "北海道": "ほっかいどう", "沖縄": "おきなわ", "横浜": "よこはま",
+	"名古屋": "なごや", "福岡": "ふくおか", "神戸": "こうべ", "広島": "ひろしま", "富士山": "ふじさん",
+	"新宿": "しんじゅく", "渋谷": "しぶや", "奈良": "なら", "札幌": "さっぽろ", "仙台": "せんだい",
+	"中国": "ちゅうごく", "韓国": "かんこく",
+	"今日": "きょう", "明日": "あした", "昨日": "きのう", "今": "いま", "時間": "じかん", "天気": "てんき",
+	"世界": "せかい", "人": "ひと", "私": "わたし", "先生": "せんせい", "学生": "がくせい", "大学": "だいがく",
+	"学校": "がっこう", "会社": "かいしゃ", "仕事": "しごと", "電車": "でんしゃ", "駅": "えき", "車": "くるま",
+	"自転車": "じてんしゃ", "飛行機": "ひこうき", "電話": "でんわ", "新聞": "しんぶん", "時計": "とけい",
+	"写真": "しゃしん", "映画": "えいが", "音楽": "おんがく", "料理": "りょうり", "寿司": "すし", "漫画": "まんが",
+	"日記": "にっき", "入門": "にゅうもん", "旅行": "りょこう", "友達": "ともだち", "家族": "かぞく",
+	"子供": "こども", "男": "おとこ", "女": "おんな", "猫": "ねこ", "犬": "いぬ", "本": "ほん", "愛": "あい",
+	"侍": "さむらい", "忍者": "にんじゃ", "空手": "からて", "柔道": "じゅうどう", "神社": "じんじゃ", "寺": "てら",
+	"城": "しろ", "山": "やま", "川": "かわ", "海": "うみ", "空": "そら", "雨": "あめ", "雪": "ゆき", "風": "かぜ",
+	"星": "ほし", "花": "はな", "桜": "さくら", "春": "はる", "夏": "なつ", "秋": "あき", "冬": "ふゆ",
+	"水": "みず", "火": "ひ", "木": "き", "金": "きん", "土": "つち", "月": "つき", "年": "ねん",
+	"東": "ひがし", "西": "にし", "南": "みなみ", "北": "きた",
+	"一": "いち", "二": "に", "三": "さん", "四": "よん", "五": "ご", "六": "ろく", "七": "なな", "八": "はち",
+	"九": "きゅう", "十": "じゅう", "百": "ひゃく", "千": "せん", "万": "まん", "円": "えん",
+})
@@ -0,0 +1,173 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goslugify
+
+import "strings"
+
+const (
+	khmerReahmuk   = 'ះ'
+	khmerToFirst   = '៉'
+	khmerToSecond  = '៊'
+	khmerSilencer  = '៍'
+	khmerCoeng     = '្'
+	khmerDigitZero = '០'
+)
+
+// khmerConsonant is a Khmer consonant with its series, the series determines the pronunciation of the
+// vowels.
+type khmerConsonant struct {
+	value  string
+	second bool
+}
+
+var khmerConsonants = map[rune]khmerConsonant{
+	'ក': {"k", false}, 'ខ': {"kh", false}, 'គ': {"k", true}, 'ឃ': {"kh", true}, 'ង': {"ng", true},
+	'ច': {"ch", false}, 'ឆ': {"chh", false}, 'ជ': {"ch", true}, 'ឈ': {"chh", true}, 'ញ': {"nh", true},
+	'ដ': {"d", false}, 'ឋ': {"th", false}, 'ឌ': {"d", true}, 'ឍ': {"th", true}, 'ណ': {"n", false},
+	'ត': {"t", false}, 'ថ': {"th", false}, 'ទ': {"t", true}, 'ធ': {"th", true}, 'ន': {"n", true},
+	'ប': {"b", false}, 'ផ': {"ph", false}, 'ព': {"p", true}, 'ភ': {"ph", true}, 'ម': {"m", true},
+	'យ': {"y", true}, 'រ': {"r", true}, 'ល': {"l", true}, 'វ': {"v", true}, 'ឝ': {"s", false},
+	'ឞ': {"s", false}, 'ស': {"s", false}, 'ហ': {"h", false}, 'ឡ': {"l", false}, 'អ': {"", false},
+}
+
+// khmerVowels contains the dependent vowels, the first entry is used after consonants of the first
+// series and the second one after consonants of the second series.
+var khmerVowels = map[string][2]string{
+	"":   {"a", "o"},
+	"ា":  {"a", "ea"},
+	"ិ":  {"e", "i"},
+	"ី":  {"ei", "i"},
+	"ឹ":  {"oe", "eu"},
+	"ឺ":  {"eu", "eu"},
+	"ុ":  {"o", "u"},
+	"ូ":  {"ou", "u"},
+	"ួ":  {"uo", "uo"},
+	"ើ":  {"aeu", "eu"},
+	"ឿ":  {"oea", "oea"},
+	"ៀ":  {"ie", "ie"},
+	"េ":  {"e", "e"},
+	"ែ":  {"ae", "ae"},
+	"ៃ":  {"ai", "ey"},
+	"ោ":  {"ao", "o"},
+	"ៅ":  {"au", "ov"},
+	"ំ":  {"am", "um"},
+	"ុំ": {"om", "um"},
+	"ាំ": {"am", "oam"},
+	"ះ":  {"ah", "eah"},
+	"ៈ":  {"a", "a"},
+}
+
+// khmerOthers contains independent vowels and punctuation.
+var khmerOthers = map[rune]string{
+	'ឣ': "a", 'ឤ': "a", 'ឥ': "e", 'ឦ': "ei", 'ឧ': "o", 'ឨ': "ou", 'ឩ': "ou", 'ឪ': "ov", 'ឫ': "rue",
+	'ឬ': "rue", 'ឭ': "lue", 'ឮ': "lue", 'ឯ': "ae", 'ឰ': "ai", 'ឱ': "ao", 'ឲ': "ao", 'ឳ': "au",
+	'។': ".", '៕': ".", '៖': ":", 'ៗ': "", '៛': "", '់': "", '៌': "", '៎': "", '៏': "", '័': "",
+}
+
+func isKhmerVowelSign(r rune) bool {
+	return (r >= 'ា' && r <= 'ៈ') || r == khmerToFirst || r == khmerToSecond || r == khmerSilencer ||
+		r == khmerCoeng
+}
+
+// TransliterateKhmer is a StringModifierFunc that romanizes Khmer according to the UNGEGN system (without
+// diacritics), for example "ភ្នំពេញ" --> "phnumpenh".
+//
+// The value of a vowel depends on the series of the consonant before it ("កា" --> "ka", "គា" --> "kea"),
+// the series shifters "៉" and "៊" are respected.
+// Subscript consonants (written with coeng) are appended to the consonant and consonants marked with
+// "៍" are silent.
+//
+// All runes that are not Khmer are not changed.
+func TransliterateKhmer(in string) string {
+	runes := []rune(in)
+	var buf strings.Builder
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		consonant, isConsonant := khmerConsonants[r]
+		if !isConsonant {
+			switch {
+			case r >= khmerDigitZero && r <= khmerDigitZero+9:
+				buf.WriteRune('0' + r - khmerDigitZero)
+			case isKhmerVowelSign(r):
+				// vowel without consonant, ignore
+			default:
+				if res, has := khmerOthers[r]; has {
+					buf.WriteString(res)
+				} else {
+					buf.WriteRune(r)
+				}
+			}
+			i++
+			continue
+		}
+		i++
+		if i < len(runes) && runes[i] == khmerSilencer {
+			i++
+			continue
+		}
+		value, second := consonant.value, consonant.second
+		// subscript consonants and series shifters
+		for i < len(runes) {
+			if runes[i] == khmerCoeng && i+1 < len(runes) {
+				if sub, has := khmerConsonants[runes[i+1]]; has {
+					value += sub.value
+					i += 2
+					continue
+				}
+			}
+			if runes[i] == khmerToFirst || runes[i] == khmerToSecond {
+				second = runes[i] == khmerToSecond
+				i++
+				continue
+			}
+			break
+		}
+		// the vowel consists of up to two signs
+		vowel := ""
+		for n := 2; n > 0; n-- {
+			if i+n <= len(runes) {
+				if _, has := khmerVowels[string(runes[i:i+n])]; has {
+					vowel = string(runes[i : i+n])
+					i += n
+					break
+				}
+			}
+		}
+		buf.WriteString(value)
+		if second {
+			buf.WriteString(khmerVowels[vowel][1])
+		} else {
+			buf.WriteString(khmerVowels[vowel][0])
+		}
+		closed := strings.ContainsAny(vowel, "ំះ")
+		if i < len(runes) && runes[i] == khmerReahmuk {
+			buf.WriteString("h")
+			closed = true
+			i++
+		}
+		// final consonant, the nikahit and reahmuk already close the syllable
+		if i < len(runes) && !closed {
+			if final, has := khmerConsonants[runes[i]]; has {
+				if i+1 >= len(runes) || !isKhmerVowelSign(runes[i+1]) {
+					buf.WriteString(final.value)
+					i++
+				} else if runes[i+1] == khmerSilencer {
+					i += 2
+				}
+			}
+		}
+	}
+	return buf.String()
+}
@@ -0,0 +1,271 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goslugify
+
+import "strings"
+
+const (
+	hangulFirst      = 0xAC00
+	hangulLast       = 0xD7A3
+	hangulVowelCount = 21
+	hangulFinalCount = 28
+)
+
+// indices of some jamo in the initial / final lists
+const (
+	initialG = 0
+	initialN = 2
+	initialD = 3
+	initialR = 5
+	initialM = 6
+	initialB = 7
+	initialS = 9
+	initialO = 11
+	initialJ = 12
+	initialH = 18
+
+	vowelI = 20
+)
+
+const (
+	finalNone = iota
+	finalG
+	finalGG
+	finalGS
+	finalN
+	finalNJ
+	finalNH
+	finalD
+	finalL
+	finalLG
+	finalLM
+	finalLB
+	finalLS
+	finalLT
+	finalLP
+	finalLH
+	finalM
+	finalB
+	finalBS
+	finalS
+	finalSS
+	finalNG
+	finalJ
+	finalCH
+	finalK
+	finalT
+	finalP
+	finalH
+)
+
+var hangulInitials = [...]string{
+	"g", "kk", "n", "d", "tt", "r", "m", "b", "pp", "s", "ss", "", "j", "jj", "ch", "k", "t", "p", "h",
+}
+
+var hangulVowels = [...]string{
+	"a", "ae", "ya", "yae", "eo", "e", "yeo", "ye", "o", "wa", "wae", "oe", "yo", "u", "wo", "we", "wi", "yu",
+	"eu", "ui", "i",
+}
+
+// hangulFinals contains the romanization of the finals at the end of a word (or before a consonant
+// without special rules).
+var hangulFinals = [...]string{
+	"", "k", "k", "k", "n", "n", "n", "t", "l", "k", "m", "l", "l", "l", "p", "l", "m", "p", "p", "t", "t", "ng",
+	"t", "t", "k", "t", "p", "t",
+}
+
+// hangulLiaison contains the romanization of the finals if the next syllable starts with a vowel,
+// the first entry remains the final and the second one becomes the initial of the next syllable.
+var hangulLiaison = [...][2]string{
+	{"", ""}, {"", "g"}, {"", "kk"}, {"k", "s"}, {"", "n"}, {"n", "j"}, {"", "n"}, {"", "d"}, {"", "r"},
+	{"l", "g"}, {"l", "m"}, {"l", "b"}, {"l", "s"}, {"l", "t"}, {"l", "p"}, {"", "r"}, {"", "m"}, {"", "b"},
+	{"p", "s"}, {"", "s"}, {"", "ss"}, {"ng", ""}, {"", "j"}, {"", "ch"}, {"", "k"}, {"", "t"}, {"", "p"},
+	{"", ""},
+}
+
+// hangulSyllable is a decomposed Hangul syllable.
+type hangulSyllable struct {
+	initial, vowel, final int
+}
+
+func decomposeHangul(r rune) (hangulSyllable, bool) {
+	if r < hangulFirst || r > hangulLast {
+		return hangulSyllable{}, false
+	}
+	index := int(r - hangulFirst)
+	return hangulSyllable{
+		initial: index / (hangulVowelCount * hangulFinalCount),
+		vowel:   (index % (hangulVowelCount * hangulFinalCount)) / hangulFinalCount,
+		final:   index % hangulFinalCount,
+	}, true
+}
+
+// hangulFinalClass returns 'k', 't' or 'p' depending on the sound of the final at the end of a syllable.
+func hangulFinalClass(final int) byte {
+	switch final {
+	case finalG, finalGG, finalGS, finalLG, finalK:
+		return 'k'
+	case finalD, finalS, finalSS, finalJ, finalCH, finalT, finalH:
+		return 't'
+	case finalB, finalBS, finalLP, finalP:
+		return 'p'
+	default:
+		return 0
+	}
+}
+
+// romanizeHangulBoundary returns the romanization of the final of a syllable and the initial of the next
+// syllable, taking into account the assimilation rules of the Revised Romanization.
+func romanizeHangulBoundary(final int, next hangulSyllable) (string, string) {
+	class := hangulFinalClass(final)
+	switch next.initial {
+	case initialO:
+		// palatalization: "ㄷ" and "ㅌ" before "이"
+		if next.vowel == vowelI {
+			switch final {
+			case finalD:
+				return "", "j"
+			case finalT:
+				return "", "ch"
+			case finalLT:
+				return "l", "ch"
+			}
+		}
+		res := hangulLiaison[final]
+		return res[0], res[1]
+	case initialH:
+		// aspiration: "ㄱ", "ㄷ", "ㅂ" and "ㅈ" followed by "ㅎ"
+		switch final {
+		case finalG, finalGG:
+			return "", "k"
+		case finalLG:
+			return "l", "k"
+		case finalD:
+			if next.vowel == vowelI {
+				return "", "ch"
+			}
+			return "", "t"
+		case finalB:
+			return "", "p"
+		case finalLB:
+			return "l", "p"
+		case finalJ:
+			return "", "ch"
+		}
+	case initialG, initialD, initialJ:
+		// aspiration: "ㅎ" followed by "ㄱ", "ㄷ" or "ㅈ"
+		switch final {
+		case finalH, finalNH, finalLH:
+			aspirated := map[int]string{initialG: "k", initialD: "t", initialJ: "ch"}[next.initial]
+			return strings.TrimSuffix(hangulFinals[final], "t"), aspirated
+		}
+	case initialN, initialM:
+		// nasalization
+		switch {
+		case final == finalL || final == finalLH:
+			if next.initial == initialN {
+				return "l", "l"
+			}
+		case class == 'k':
+			return "ng", hangulInitials[next.initial]
+		case class == 't':
+			return "n", hangulInitials[next.initial]
+		case class == 'p':
+			return "m", hangulInitials[next.initial]
+		}
+	case initialR:
+		switch {
+		case final == finalN || final == finalL:
+			return "l", "l"
+		case final == finalM || final == finalNG:
+			return hangulFinals[final], "n"
+		case class == 'k':
+			return "ng", "n"
+		case class == 't':
+			return "n", "n"
+		case class == 'p':
+			return "m", "n"
+		}
+	}
+	return hangulFinals[final], hangulInitials[next.initial]
+}
+
+func romanizeHangulRun(syllables []hangulSyllable) string {
+	var buf strings.Builder
+	initial := ""
+	if len(syllables) > 0 {
+		initial = hangulInitials[syllables[0].initial]
+	}
+	for i, syllable := range syllables {
+		buf.WriteString(initial)
+		buf.WriteString(hangulVowels[syllable.vowel])
+		if i+1 < len(syllables) {
+			var final string
+			final, initial = romanizeHangulBoundary(syllable.final, syllables[i+1])
+			buf.WriteString(final)
+		} else {
+			buf.WriteString(hangulFinals[syllable.final])
+		}
+	}
+	return buf.String()
+}
+
+// romanizeJamo romanizes a single conjoining jamo (NFKC transforms compatibility jamo to conjoining jamo).
+func romanizeJamo(r rune) (string, bool) {
+	switch {
+	case r >= 0x1100 && r <= 0x1112:
+		if r == 0x110B {
+			return "", true
+		}
+		return hangulInitials[r-0x1100], true
+	case r >= 0x1161 && r <= 0x1175:
+		return hangulVowels[r-0x1161], true
+	case r >= 0x11A8 && r <= 0x11C2:
+		return hangulFinals[r-0x11A8+1], true
+	}
+	return "", false
+}
+
+// TransliterateKorean is a StringModifierFunc that romanizes Hangul according to the Revised Romanization
+// of Korean.
+//
+// Hangul syllables are decomposed into jamo algorithmically, the assimilation rules at syllable boundaries
+// are applied: The final consonant moves to the next syllable if it starts with a vowel
+// ("한국어" --> "hangugeo"), nasalization ("국민" --> "gungmin"), "ㄹ" assimilation ("신라" --> "silla",
+// "종로" --> "jongno"), aspiration with "ㅎ" ("좋고" --> "joko") and palatalization ("같이" --> "gachi").
+// Tensification is not reflected in the Revised Romanization and thus not applied.
+//
+// Hanja and all runes that are not Hangul are not changed.
+func TransliterateKorean(in string) string {
+	var buf strings.Builder
+	var run []hangulSyllable
+	for _, r := range in {
+		if syllable, ok := decomposeHangul(r); ok {
+			run = append(run, syllable)
+			continue
+		}
+		if len(run) > 0 {
+			buf.WriteString(romanizeHangulRun(run))
+			run = run[:0]
+		}
+		if jamo, ok := romanizeJamo(r); ok {
+			buf.WriteString(jamo)
+		} else {
+			buf.WriteRune(r)
+		}
+	}
+	buf.WriteString(romanizeHangulRun(run))
+	return buf.String()
+}
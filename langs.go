@@ -14,9 +14,55 @@
 
 package goslugify
 
+import (
+	"golang.org/x/text/language"
+	"strings"
+)
+
 const (
-	LanguageEnglish = "en"
-	LanguageGerman  = "de"
+	LanguageEnglish    = "en"
+	LanguageGerman     = "de"
+	LanguageRussian    = "ru"
+	LanguageUkrainian  = "uk"
+	LanguageBulgarian  = "bg"
+	LanguageSerbian    = "sr"
+	LanguageMacedonian = "mk"
+	LanguageBelarusian = "be"
+	LanguageGreek      = "el"
+	LanguageChinese    = "zh"
+	LanguageJapanese   = "ja"
+	LanguageKorean     = "ko"
+	LanguageArabic     = "ar"
+	LanguagePersian    = "fa"
+	LanguageUrdu       = "ur"
+	LanguageHebrew     = "he"
+	LanguageHindi      = "hi"
+	LanguageMarathi    = "mr"
+	LanguageNepali     = "ne"
+	LanguageBengali    = "bn"
+	LanguageTamil      = "ta"
+	LanguageTelugu     = "te"
+	LanguageThai       = "th"
+	LanguageLao        = "lo"
+	LanguageKhmer      = "km"
+	LanguageArmenian   = "hy"
+	LanguageGeorgian   = "ka"
+	LanguageAmharic    = "am"
+	LanguageVietnamese = "vi"
+	LanguageDanish     = "da"
+	LanguageNorwegian  = "no"
+	LanguageBokmal     = "nb"
+	LanguageNynorsk    = "nn"
+	LanguageSwedish    = "sv"
+	LanguageFinnish    = "fi"
+	LanguageDutch      = "nl"
+	LanguageTurkish    = "tr"
+	LanguageIcelandic  = "is"
+	LanguagePolish     = "pl"
+	LanguageCzech      = "cs"
+	LanguageSlovak     = "sk"
+	LanguageHungarian  = "hu"
+	LanguageRomanian   = "ro"
 )
 
 // EnglishReplaceDict contains replacers for "@" ("at") and "&" ("and").
@@ -31,30 +77,392 @@ var GermanReplaceDict = map[string]string{
 	"&": "und",
 }
 
-var languageMaps = make(map[string]StringReplaceMap, 2)
+// CyrillicReplaceDict contains replacers for "@" ("at") and "&" ("i"), it is used for all languages
+// written in Cyrillic ("и" is transliterated to "i" in these languages).
+var CyrillicReplaceDict = map[string]string{
+	"@": "at",
+	"&": "i",
+}
+
+// GreekReplaceDict contains replacers for "@" ("at") and "&" ("kai").
+var GreekReplaceDict = map[string]string{
+	"@": "at",
+	"&": "kai",
+}
+
+// ChineseReplaceDict contains replacers for "@" ("at") and "&" ("he").
+var ChineseReplaceDict = map[string]string{
+	"@": "at",
+	"&": "he",
+}
+
+// JapaneseReplaceDict contains replacers for "@" ("at") and "&" ("to").
+var JapaneseReplaceDict = map[string]string{
+	"@": "at",
+	"&": "to",
+}
+
+// KoreanReplaceDict contains replacers for "@" ("at") and "&" ("gwa").
+var KoreanReplaceDict = map[string]string{
+	"@": "at",
+	"&": "gwa",
+}
+
+// ArabicReplaceDict contains replacers for "@" ("at") and "&" ("wa").
+var ArabicReplaceDict = map[string]string{
+	"@": "at",
+	"&": "wa",
+}
+
+// PersianReplaceDict contains replacers for "@" ("at") and "&" ("va").
+var PersianReplaceDict = map[string]string{
+	"@": "at",
+	"&": "va",
+}
+
+// UrduReplaceDict contains replacers for "@" ("at") and "&" ("aur").
+var UrduReplaceDict = map[string]string{
+	"@": "at",
+	"&": "aur",
+}
+
+// HebrewReplaceDict contains replacers for "@" ("at") and "&" ("ve").
+var HebrewReplaceDict = map[string]string{
+	"@": "at",
+	"&": "ve",
+}
+
+// HindiReplaceDict contains replacers for "@" ("at") and "&" ("aur").
+var HindiReplaceDict = map[string]string{
+	"@": "at",
+	"&": "aur",
+}
+
+// MarathiReplaceDict contains replacers for "@" ("at") and "&" ("ani").
+var MarathiReplaceDict = map[string]string{
+	"@": "at",
+	"&": "ani",
+}
+
+// NepaliReplaceDict contains replacers for "@" ("at") and "&" ("ra").
+var NepaliReplaceDict = map[string]string{
+	"@": "at",
+	"&": "ra",
+}
+
+// BengaliReplaceDict contains replacers for "@" ("at") and "&" ("o").
+var BengaliReplaceDict = map[string]string{
+	"@": "at",
+	"&": "o",
+}
+
+// TamilReplaceDict contains replacers for "@" ("at") and "&" ("matrum").
+var TamilReplaceDict = map[string]string{
+	"@": "at",
+	"&": "matrum",
+}
+
+// TeluguReplaceDict contains replacers for "@" ("at") and "&" ("mariyu").
+var TeluguReplaceDict = map[string]string{
+	"@": "at",
+	"&": "mariyu",
+}
+
+// ThaiReplaceDict contains replacers for "@" ("at") and "&" ("lae").
+var ThaiReplaceDict = map[string]string{
+	"@": "at",
+	"&": "lae",
+}
+
+// LaoReplaceDict contains replacers for "@" ("at") and "&" ("lae").
+var LaoReplaceDict = map[string]string{
+	"@": "at",
+	"&": "lae",
+}
+
+// KhmerReplaceDict contains replacers for "@" ("at") and "&" ("ning").
+var KhmerReplaceDict = map[string]string{
+	"@": "at",
+	"&": "ning",
+}
+
+// ArmenianReplaceDict contains replacers for "@" ("at") and "&" ("yev").
+var ArmenianReplaceDict = map[string]string{
+	"@": "at",
+	"&": "yev",
+}
+
+// GeorgianReplaceDict contains replacers for "@" ("at") and "&" ("da").
+var GeorgianReplaceDict = map[string]string{
+	"@": "at",
+	"&": "da",
+}
+
+// SwissGermanReplaceDict contains the regional overrides for Swiss German ("de-CH"): "ß" is not used in
+// Switzerland and replaced by "ss" (this is relevant for Unicode slugs, otherwise "ß" becomes "ss" anyway).
+// The entries of GermanReplaceDict are used as a fallback.
+var SwissGermanReplaceDict = map[string]string{
+	"ß": "ss",
+}
+
+// AustrianGermanWordDict contains the regional word overrides for Austrian German ("de-AT"): The Austrian
+// month names "Jänner" and "Feber" are replaced by "januar" and "februar", so texts from Austria and Germany
+// get the same slugs. Only complete words are replaced ("Jännerwetter" is not changed), see
+// LanguageRegistry.AddWords.
+var AustrianGermanWordDict = map[string]string{
+	"jänner": "januar",
+	"feber":  "februar",
+}
+
+// AmharicReplaceDict contains replacers for "@" ("at") and "&" ("ena").
+var AmharicReplaceDict = map[string]string{
+	"@": "at",
+	"&": "ena",
+}
+
+// VietnameseReplaceDict contains replacers for "@" ("at") and "&" ("va").
+var VietnameseReplaceDict = map[string]string{
+	"@": "at",
+	"&": "va",
+}
+
+// latinLanguages contains the word for "and" and the letter expansions of the languages written in the
+// Latin script that are registered in addBuiltinLanguages.
+var latinLanguages = map[string]struct {
+	and     string
+	letters map[rune]string
+}{
+	LanguageDanish:    {"og", DanishLetters},
+	LanguageNorwegian: {"og", DanishLetters},
+	LanguageBokmal:    {"og", DanishLetters},
+	LanguageNynorsk:   {"og", DanishLetters},
+	LanguageSwedish:   {"och", SwedishLetters},
+	LanguageFinnish:   {"ja", FinnishLetters},
+	LanguageDutch:     {"en", DutchLetters},
+	LanguageTurkish:   {"ve", TurkishLetters},
+	LanguageIcelandic: {"og", IcelandicLetters},
+	LanguagePolish:    {"i", PolishLetters},
+	LanguageCzech:     {"a", CzechLetters},
+	LanguageSlovak:    {"a", SlovakLetters},
+	LanguageHungarian: {"es", HungarianLetters},
+	LanguageRomanian:  {"si", RomanianLetters},
+}
+
+// addBuiltinLanguages registers the replace maps and transliterators of the built-in languages, it is called
+// before the registry is shared, so no locking is required.
+func (registry *LanguageRegistry) addBuiltinLanguages() {
+	registry.maps[LanguageEnglish] = EnglishReplaceDict
+	registry.maps[LanguageGerman] = GermanReplaceDict
+	registry.words["de-AT"] = AustrianGermanWordDict
+	registry.maps["de-CH"] = SwissGermanReplaceDict
+
+	for _, language := range []string{LanguageRussian, LanguageUkrainian, LanguageBulgarian,
+		LanguageSerbian, LanguageMacedonian, LanguageBelarusian} {
+		registry.maps[language] = CyrillicReplaceDict
+		transliterator := NewCyrillicTransliterator(CyrillicBGNPCGN, language)
+		registry.transliterators[language] = ToStringHandleFunc(transliterator)
+	}
+
+	registry.maps[LanguageGreek] = GreekReplaceDict
+	registry.transliterators[LanguageGreek] = TransliterateGreek
+
+	registry.maps[LanguageChinese] = ChineseReplaceDict
+	registry.transliterators[LanguageChinese] = ToStringHandleFunc(NewPinyinTransliterator())
+
+	registry.maps[LanguageJapanese] = JapaneseReplaceDict
+	registry.transliterators[LanguageJapanese] = ToStringHandleFunc(NewJapaneseTransliterator(DefaultKanjiDictionary))
+
+	registry.maps[LanguageKorean] = KoreanReplaceDict
+	registry.transliterators[LanguageKorean] = TransliterateKorean
+
+	registry.maps[LanguageArabic] = ArabicReplaceDict
+	registry.transliterators[LanguageArabic] = runeTransliterator(TransliterateArabic)
+	registry.maps[LanguagePersian] = PersianReplaceDict
+	registry.transliterators[LanguagePersian] = runeTransliterator(TransliteratePersian)
+	registry.maps[LanguageUrdu] = UrduReplaceDict
+	registry.transliterators[LanguageUrdu] = runeTransliterator(TransliterateUrdu)
+
+	registry.maps[LanguageHebrew] = HebrewReplaceDict
+	registry.transliterators[LanguageHebrew] = TransliterateHebrew
+
+	registry.maps[LanguageHindi] = HindiReplaceDict
+	registry.maps[LanguageMarathi] = MarathiReplaceDict
+	registry.maps[LanguageNepali] = NepaliReplaceDict
+	registry.maps[LanguageBengali] = BengaliReplaceDict
+	registry.maps[LanguageTamil] = TamilReplaceDict
+	registry.maps[LanguageTelugu] = TeluguReplaceDict
+	for _, language := range []string{LanguageHindi, LanguageMarathi, LanguageNepali, LanguageBengali} {
+		registry.transliterators[language] = ToStringHandleFunc(NewIndicTransliterator(true))
+	}
+	for _, language := range []string{LanguageTamil, LanguageTelugu} {
+		registry.transliterators[language] = ToStringHandleFunc(NewIndicTransliterator(false))
+	}
+
+	registry.maps[LanguageThai] = ThaiReplaceDict
+	registry.transliterators[LanguageThai] = TransliterateThai
+	registry.maps[LanguageLao] = LaoReplaceDict
+	registry.transliterators[LanguageLao] = TransliterateLao
+	registry.maps[LanguageKhmer] = KhmerReplaceDict
+	registry.transliterators[LanguageKhmer] = TransliterateKhmer
+
+	registry.maps[LanguageArmenian] = ArmenianReplaceDict
+	registry.transliterators[LanguageArmenian] = ToStringHandleFunc(NewArmenianTransliterator())
+	registry.maps[LanguageGeorgian] = GeorgianReplaceDict
+	registry.transliterators[LanguageGeorgian] = runeTransliterator(TransliterateGeorgian)
+	registry.maps[LanguageAmharic] = AmharicReplaceDict
+	registry.transliterators[LanguageAmharic] = runeTransliterator(TransliterateEthiopic)
+
+	registry.maps[LanguageVietnamese] = VietnameseReplaceDict
+	registry.transliterators[LanguageVietnamese] = runeTransliterator(TransliterateVietnamese)
+
+	// the letter expansions are transliterators and not part of the replace maps, thus they're not used in
+	// Unicode slugs
+	for language, entry := range latinLanguages {
+		registry.maps[language] = StringReplaceMap{"@": "at", "&": entry.and}
+		registry.transliterators[language] = letterTransliterator(entry.letters)
+	}
+
+	for language, words := range languageSymbolWords {
+		registry.maps[language] = MergeStringReplaceMaps(registry.maps[language], NewSymbolReplaceMap(words))
+	}
+
+	for language, words := range builtinStopWords {
+		phrases := strings.Fields(words)
+		for i, phrase := range phrases {
+			phrases[i] = strings.ReplaceAll(phrase, "_", " ")
+		}
+		registry.stopWords[language] = NewStopWords(phrases...)
+	}
+}
 
-func init() {
-	languageMaps[LanguageEnglish] = EnglishReplaceDict
-	languageMaps[LanguageGerman] = GermanReplaceDict
+// runeTransliterator converts a RuneHandleFunc to a transliterator, runes not handled by f are kept.
+func runeTransliterator(f RuneHandleFunc) StringModifierFunc {
+	return RuneHandleFuncToStringModifierFunc(ChainRuneHandleFuncs(f, KeepAllFunc))
 }
 
-// AddLanguageMap adds a new language to the global language map store.
+// languageKey returns the key of a language in the language stores: The canonical form of the BCP 47
+// language tag ("de_at" becomes "de-AT"), if l is not a valid tag l is returned unchanged.
+func languageKey(l string) string {
+	tag, err := language.Parse(l)
+	if err != nil {
+		return l
+	}
+	return tag.String()
+}
+
+// LanguageFallbacks returns the fallback chain of a BCP 47 language tag, starting with the tag itself
+// and ending with "und" (the root language).
+// The chain contains the parents as defined in CLDR and the base language, for example "de-CH" --> "de" -->
+// "und", "en-GB" --> "en-001" --> "en" --> "und" and "zh-TW" --> "zh-Hant" --> "zh" --> "und".
+// The tags are in canonical form, if language is not a valid tag the chain contains only language.
+func LanguageFallbacks(l string) []string {
+	tag, err := language.Parse(l)
+	if err != nil {
+		return []string{l}
+	}
+	var res []string
+	for parent := tag; parent != language.Und; parent = parent.Parent() {
+		res = append(res, parent.String())
+	}
+	// the base language is not always a CLDR parent, for example "zh-Hant" is a child of "und"
+	if base, confidence := tag.Base(); confidence == language.Exact {
+		if baseKey := base.String(); len(res) == 0 || res[len(res)-1] != baseKey {
+			res = append(res, baseKey)
+		}
+	}
+	return append(res, language.Und.String())
+}
+
+// AddLanguageMap adds a new language to the global language store.
 // This store can be used for language specific replacements.
+//
+// language is a BCP 47 language tag, regional variants can be registered as well: A map for "de-AT" is used
+// for "de-AT" (and "de-AT-..."), the entries of "de" are used as a fallback.
+//
+// The map is added to DefaultLanguageRegistry, see LanguageRegistry.AddMap.
 func AddLanguageMap(language string, m StringReplaceMap) {
-	languageMaps[language] = m
+	DefaultLanguageRegistry.AddMap(language, m)
 }
 
-// GetLanguageMap returns a StringReplaceMap for a given list of languages.
+// GetLanguageMap returns a StringReplaceMap for a given list of languages (BCP 47 language tags).
 // All maps for the specific languages are merged with MergeStringReplaceMaps.
+// For each language the maps of its fallback chain (see LanguageFallbacks) are used, the more specific maps
+// come first: "de-CH" uses the entries of "de-CH" and then the entries of "de".
 // If a language doesn't exist the entry will be ignored.
 //
-// Supported languages right now are "en" (English) and "de" (German).
+// Supported languages right now are "en" (English), "de" (German), "ru" (Russian), "uk" (Ukrainian),
+// "bg" (Bulgarian), "sr" (Serbian), "mk" (Macedonian), "be" (Belarusian), "el" (Greek), "zh" (Chinese),
+// "ja" (Japanese), "ko" (Korean), "ar" (Arabic), "fa" (Persian), "ur" (Urdu), "he" (Hebrew), "hi" (Hindi),
+// "mr" (Marathi), "ne" (Nepali), "bn" (Bengali), "ta" (Tamil), "te" (Telugu), "th" (Thai), "lo" (Lao),
+// "km" (Khmer), "hy" (Armenian), "ka" (Georgian), "am" (Amharic), "vi" (Vietnamese), "da" (Danish),
+// "no", "nb" and "nn" (Norwegian), "sv" (Swedish), "fi" (Finnish), "nl" (Dutch), "tr" (Turkish),
+// "is" (Icelandic), "pl" (Polish), "cs" (Czech), "sk" (Slovak), "hu" (Hungarian) and "ro" (Romanian).
+// The regional variant "de-CH" has its own map (see SwissGermanReplaceDict), "de-AT" has its own word map
+// (see GetLanguageWords).
+//
+// All maps contain the names of symbols and currency signs in the language ("%" --> "percent" in English,
+// "prozent" in German), see NewSymbolReplaceMap.
+// The language specific expansions of letters of languages written in the Latin script are not part of
+// the maps, see GetLanguageTransliterator.
+//
+// The maps are looked up in DefaultLanguageRegistry, see LanguageRegistry.GetMap.
 func GetLanguageMap(languages ...string) StringReplaceMap {
-	mapList := make([]StringReplaceMap, 0, len(languages))
-	for _, l := range languages {
-		if m, has := languageMaps[l]; has {
-			mapList = append(mapList, m)
-		}
-	}
-	return MergeStringReplaceMaps(mapList...)
+	return DefaultLanguageRegistry.GetMap(languages...)
+}
+
+// AddLanguageWords adds the word map of a language to the global language store, only complete words are
+// replaced (ignoring the case).
+//
+// The word map is added to DefaultLanguageRegistry, see LanguageRegistry.AddWords.
+func AddLanguageWords(language string, m StringReplaceMap) {
+	DefaultLanguageRegistry.AddWords(language, m)
+}
+
+// GetLanguageWords returns the word map for a given list of languages (BCP 47 language tags).
+// Right now only "de-AT" has a word map (see AustrianGermanWordDict).
+//
+// The word maps are looked up in DefaultLanguageRegistry, see LanguageRegistry.GetWords.
+func GetLanguageWords(languages ...string) StringReplaceMap {
+	return DefaultLanguageRegistry.GetWords(languages...)
+}
+
+// AddLanguageTransliterator adds a new transliterator for a language to the global language store.
+// A transliterator converts a script to Latin, for example Cyrillic "жук" to "zhuk".
+//
+// language is a BCP 47 language tag, see AddLanguageMap.
+//
+// The transliterator is added to DefaultLanguageRegistry, see LanguageRegistry.AddTransliterator.
+func AddLanguageTransliterator(language string, f StringModifierFunc) {
+	DefaultLanguageRegistry.AddTransliterator(language, f)
+}
+
+// GetLanguageTransliterator returns a StringModifierFunc that applies the transliterators of all
+// given languages (in the given order).
+// For each language the most specific transliterator of its fallback chain is used (see LanguageFallbacks),
+// thus "ru-RU" uses the transliterator of "ru".
+// If a language doesn't have a transliterator the entry will be ignored.
+//
+// Transliterators exist right now for "ru", "uk", "bg", "sr", "mk" and "be" (see NewCyrillicTransliterator
+// if you want to use a different romanization standard), "el" (see TransliterateGreek), "zh" (see
+// NewPinyinTransliterator), "ja" (see JapaneseTransliterator), "ko" (see TransliterateKorean), "ar" (see
+// TransliterateArabic), "fa" (see TransliteratePersian), "ur" (see TransliterateUrdu), "he" (see
+// TransliterateHebrew), "hi", "mr", "ne", "bn", "ta" and "te" (see IndicTransliterator), "th" (see
+// TransliterateThai), "lo" (see TransliterateLao), "km" (see TransliterateKhmer), "hy" (see
+// NewArmenianTransliterator), "ka" (see TransliterateGeorgian), "am" (see TransliterateEthiopic) and "vi"
+// (see TransliterateVietnamese).
+// The languages written in the Latin script "da", "no", "nb", "nn", "sv", "fi", "nl", "tr", "is", "pl", "cs",
+// "sk", "hu" and "ro" have transliterators for their language specific expansions of letters, for example
+// "ö" becomes "o" in Swedish (instead of "oe" as in German), see DanishLetters etc.
+//
+// The transliterators are looked up in DefaultLanguageRegistry, see LanguageRegistry.GetTransliterator.
+func GetLanguageTransliterator(languages ...string) StringModifierFunc {
+	return DefaultLanguageRegistry.GetTransliterator(languages...)
+}
+
+// GetStopWords returns the stop words of the given languages (BCP 47 language tags), see
+// LanguageRegistry.GetStopWords. All built-in languages (see GetLanguageMap) have stop words.
+//
+// The stop words are looked up in DefaultLanguageRegistry.
+func GetStopWords(languages ...string) StopWords {
+	return DefaultLanguageRegistry.GetStopWords(languages...)
 }
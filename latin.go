@@ -0,0 +1,105 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goslugify
+
+import "unicode"
+
+// The following maps contain the language specific ASCII expansions of letters for languages written
+// in the Latin script. They're registered as the transliterators of the languages, so they're not used in
+// Unicode slugs. Only lower case letters are listed, the upper case variants are added when the maps are
+// registered (see LetterReplaceMap).
+//
+// Letters that are not listed are handled by the default processors (TranslateUmlaut and
+// TranslateDiacritics), so the maps only contain letters for which the language has its own convention
+// or which would be handled differently by default (for example the German rules for "ö").
+
+// DanishLetters contains the expansions for Danish and Norwegian.
+var DanishLetters = map[rune]string{
+	'æ': "ae", 'ø': "oe", 'å': "aa",
+}
+
+// SwedishLetters contains the expansions for Swedish.
+var SwedishLetters = map[rune]string{
+	'å': "a", 'ä': "a", 'ö': "o", 'æ': "ae", 'ø': "o",
+}
+
+// FinnishLetters contains the expansions for Finnish.
+var FinnishLetters = map[rune]string{
+	'å': "a", 'ä': "a", 'ö': "o",
+}
+
+// DutchLetters contains the expansions for Dutch, the diaeresis only marks a new syllable and is thus
+// simply removed.
+var DutchLetters = map[rune]string{
+	'ĳ': "ij", 'ä': "a", 'ë': "e", 'ï': "i", 'ö': "o", 'ü': "u",
+}
+
+// TurkishLetters contains the expansions for Turkish.
+var TurkishLetters = map[rune]string{
+	'ı': "i", 'ş': "s", 'ğ': "g", 'ç': "c", 'ö': "o", 'ü': "u", 'â': "a", 'î': "i", 'û': "u",
+}
+
+// IcelandicLetters contains the expansions for Icelandic.
+var IcelandicLetters = map[rune]string{
+	'þ': "th", 'ð': "d", 'æ': "ae", 'ö': "o",
+}
+
+// PolishLetters contains the expansions for Polish.
+var PolishLetters = map[rune]string{
+	'ą': "a", 'ć': "c", 'ę': "e", 'ł': "l", 'ń': "n", 'ó': "o", 'ś': "s", 'ź': "z", 'ż': "z",
+}
+
+// CzechLetters contains the expansions for Czech.
+var CzechLetters = map[rune]string{
+	'á': "a", 'č': "c", 'ď': "d", 'é': "e", 'ě': "e", 'í': "i", 'ň': "n", 'ó': "o", 'ř': "r", 'š': "s",
+	'ť': "t", 'ú': "u", 'ů': "u", 'ý': "y", 'ž': "z",
+}
+
+// SlovakLetters contains the expansions for Slovak.
+var SlovakLetters = map[rune]string{
+	'á': "a", 'ä': "a", 'č': "c", 'ď': "d", 'é': "e", 'í': "i", 'ĺ': "l", 'ľ': "l", 'ň': "n", 'ó': "o",
+	'ô': "o", 'ŕ': "r", 'š': "s", 'ť': "t", 'ú': "u", 'ý': "y", 'ž': "z",
+}
+
+// HungarianLetters contains the expansions for Hungarian.
+var HungarianLetters = map[rune]string{
+	'á': "a", 'é': "e", 'í': "i", 'ó': "o", 'ö': "o", 'ő': "o", 'ú': "u", 'ü': "u", 'ű': "u",
+}
+
+// RomanianLetters contains the expansions for Romanian, both the comma and the (wrong but widely used)
+// cedilla forms of "ș" and "ț" are included.
+var RomanianLetters = map[rune]string{
+	'ă': "a", 'â': "a", 'î': "i", 'ș': "s", 'ş': "s", 'ț': "t", 'ţ': "t",
+}
+
+// LetterReplaceMap converts a map of letter expansions to a StringReplaceMap that can be used for example
+// in SlugConfig.AddReplaceMap or AddLanguageMap.
+// For each lower case letter the upper case letter is added as well, "æ" --> "ae" gives "Æ" --> "Ae".
+func LetterReplaceMap(letters map[rune]string) StringReplaceMap {
+	res := make(StringReplaceMap, 2*len(letters))
+	for r, to := range letters {
+		res[string(r)] = to
+		if upper := unicode.ToUpper(r); upper != r {
+			res[string(upper)] = toTitle(to)
+		}
+	}
+	return res
+}
+
+// letterTransliterator returns a transliterator that applies the letter expansions (see LetterReplaceMap),
+// it is used to register letter expansions in a LanguageRegistry.
+func letterTransliterator(letters map[rune]string) StringModifierFunc {
+	return ToStringHandleFunc(NewConstantReplacerFromMap(LetterReplaceMap(letters)))
+}
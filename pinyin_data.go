@@ -0,0 +1,562 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goslugify
+
+// pinyinCharacterData contains the toneless Pinyin syllables followed by all characters
+// (simplified and some common traditional ones) with this reading.
+// For characters with more than one reading only the most common one is listed here,
+// other readings are covered by pinyinPhraseData.
+// "ü" is written as "v" (as usual in ASCII Pinyin).
+const pinyinCharacterData = `a 啊阿吖锕
+ai 爱哀埃挨矮艾碍癌唉蔼隘哎皑霭愛礙
+an 安按案暗岸俺鞍氨庵黯谙胺
+ang 昂肮盎
+ao 奥澳傲熬凹袄敖翱懊鳌奧襖
+ba 八把爸巴拔吧霸坝扒疤芭捌叭靶跋罢耙笆壩罷
+bai 白百摆败拜柏佰掰擺敗
+ban 半办板班般版搬伴扮瓣拌颁斑绊扳阪辦頒
+bang 帮邦棒榜膀绑磅傍谤梆幫綁
+bao 包保报宝抱爆饱暴胞豹堡鲍褒苞雹煲報寶飽
+bei 北被备背杯悲辈贝倍碑卑惫狈焙備輩貝
+ben 本奔笨苯
+beng 崩泵绷蹦甭迸
+bi 比必笔闭避鼻毕币壁彼逼碧蔽弊鄙敝臂痹庇毙婢弼陛璧畢筆閉幣斃
+bian 边变便遍编辩鞭贬扁辨辫卞匾邊變編辯貶
+biao 表标彪膘飙鳔標錶
+bie 别憋瘪彆
+bin 宾滨斌彬濒缤殡鬓賓濱
+bing 病并兵冰饼丙柄秉禀炳併餅
+bo 波播伯博玻拨剥搏勃驳钵泊舶脖渤薄帛跛簸菠鉑撥駁
+bu 不部步布补捕簿卜哺埠怖佈補
+ca 擦
+cai 才采菜财材彩猜裁踩睬財採
+can 参残餐惨灿蚕惭參殘慘燦
+cang 藏仓苍舱沧倉蒼艙
+cao 草操曹槽糙嘈
+ce 策测侧册厕側冊測廁
+cen 岑
+ceng 层曾蹭層
+cha 查茶差插察叉岔诧搽茬碴
+chai 柴拆豺
+chan 产禅缠蝉馋掺铲颤阐谗潺產纏禪蟬
+chang 长長常场厂唱肠昌畅尝偿敞倡猖嫦場廠腸暢嘗償
+chao 朝超抄吵潮巢炒钞嘲
+che 车彻撤扯澈車徹
+chen 陈沉晨尘臣衬趁辰忱琛陳塵襯
+cheng 成城程称承乘诚呈撑惩橙澄秤逞誠稱
+chi 吃持池迟尺赤齿翅斥耻痴驰匙侈弛遲齒馳
+chong 冲充虫崇宠憧衝蟲寵
+chou 抽丑愁仇筹酬臭绸稠畴踌瞅醜籌
+chu 出处初除础储楚触厨畜锄雏橱處礎儲觸廚
+chuai 揣踹
+chuan 川穿传船串喘椽傳
+chuang 床创窗闯疮幢創闖
+chui 吹垂锤捶炊槌錘
+chun 春纯唇醇蠢淳椿純
+chuo 戳绰辍
+ci 此次词辞刺磁雌慈瓷赐祠茨詞辭賜
+cong 从丛匆聪葱囱從叢聰
+cou 凑湊
+cu 粗促醋簇蹴
+cuan 窜篡蹿竄
+cui 催脆翠崔摧粹萃淬瘁璀
+cun 村存寸忖
+cuo 错措挫撮搓锉錯
+da 大打达答搭沓耷褡達
+dai 带代待戴袋贷呆歹逮怠殆黛帶貸
+dan 但单担蛋淡胆丹诞旦耽氮掸單擔膽誕
+dang 当党档挡荡裆當黨檔擋蕩
+dao 到道导倒岛刀稻盗悼捣蹈祷叨導島盜禱
+de 的得德锝
+dei 嘚
+deng 等登灯邓凳瞪蹬燈鄧
+di 地第低底帝敌弟滴抵递堤笛狄涤嫡缔蒂敵遞滌締
+dian 点电店典殿垫淀颠奠碘甸巅掂惦點電墊顛
+diao 调掉吊雕钓刁碉貂調釣
+die 跌叠爹蝶碟谍迭疊諜
+ding 定顶丁订盯钉鼎叮锭頂訂釘
+diu 丢丟
+dong 东动冬懂洞董冻栋侗咚東動凍棟
+dou 都斗豆抖逗陡兜鬥
+du 度读独毒督渡杜肚堵赌妒镀睹笃讀獨賭
+duan 段短断端锻缎斷鍛
+dui 对队堆兑對隊
+dun 顿吨蹲盾敦墩钝遁頓噸
+duo 多夺朵躲惰舵堕跺哆奪墮
+e 饿恶额俄鹅蛾扼遏厄鄂餓惡額鵝
+ei 诶
+en 恩嗯摁
+er 而二儿耳尔饵洱贰兒爾
+fa 发法罚乏伐阀筏發罰閥
+fan 反饭犯范翻番繁凡烦返泛帆贩樊藩梵飯範煩販
+fang 方放房防访仿芳妨纺坊肪訪紡
+fei 非飞费肥废肺菲匪沸诽翡吠妃飛費廢
+fen 分份粉奋愤纷芬坟焚粪氛吩奮憤紛墳糞
+feng 风丰封峰锋蜂疯奉凤缝讽枫烽逢風豐鋒瘋鳳諷楓
+fo 佛
+fou 否
+fu 父服福夫复府付富副妇负附扶浮符幅腐伏肤辅赋赴抚俘拂甫腹覆缚孵敷斧芙袱複婦負膚輔賦撫
+ga 嘎噶
+gai 该改概盖钙丐溉該蓋
+gan 干感敢赶甘肝杆竿柑尴秆幹趕桿
+gang 刚钢港岗纲缸杠剛鋼崗綱
+gao 高告搞稿糕膏皋
+ge 个各歌格哥革隔割阁戈鸽搁葛個閣鴿擱
+gei 给給
+gen 根跟亘
+geng 更耕耿庚羹梗
+gong 工公共功供攻宫弓恭躬巩拱贡宮鞏貢
+gou 够构狗沟购钩勾苟垢構溝購鉤夠
+gu 古故股顾鼓骨谷固孤姑估雇辜菇箍沽咕顧穀僱
+gua 挂瓜刮寡褂掛
+guai 怪乖拐
+guan 关管观官馆惯冠贯罐灌棺關觀館慣貫
+guang 光广逛廣
+gui 规贵鬼归轨桂柜跪瑰龟硅诡規貴歸軌櫃龜
+gun 滚棍辊滾
+guo 国过果锅郭裹國過鍋
+ha 哈蛤
+hai 还還海孩害亥骇氦駭
+han 汉含寒喊汗韩函罕涵憨旱捍焊撼翰漢韓
+hang 航杭夯
+hao 好号毫豪耗浩郝嚎壕號
+he 和何合河喝核盒贺荷禾赫鹤呵賀鶴
+hei 黑嘿
+hen 很恨狠痕
+heng 横恒衡哼亨橫恆
+hong 红洪宏鸿虹轰哄烘弘紅鴻轟
+hou 后候厚猴喉吼侯後
+hu 湖户护呼胡虎互忽乎壶狐糊蝴弧葫唬沪戶護壺滬
+hua 话化花华画划滑哗猾話華畫劃
+huai 坏怀淮槐徊壞懷
+huan 换欢环缓幻患唤焕桓宦痪換歡環緩喚
+huang 黄皇荒慌晃煌谎凰惶蝗恍簧黃謊
+hui 会回汇挥灰辉毁悔慧惠绘徽讳晦恢秽賄會匯揮輝毀繪
+hun 婚混魂昏浑荤渾
+huo 或活火获货伙祸惑霍豁獲貨禍夥
+ji 机几及级即基己记计急集技积极济际既继击寄激迹纪挤鸡吉季肌籍剂饥疾圾绩辑棘讥忌冀祭寂姬缉嫉藉機幾級記計積極濟際繼擊跡紀擠雞劑飢績輯譏
+jia 家加价假架甲夹佳嘉驾嫁稼颊荚價夾駕頰
+jian 见间建件简检减渐坚健监剑键箭肩尖兼舰践鉴艰煎拣柬俭茧剪溅荐贱捡碱見間簡檢減漸堅監劍鍵艦踐鑑艱揀儉繭濺薦賤撿
+jiang 将讲江降奖蒋酱浆疆僵姜匠桨將講獎蔣醬漿槳
+jiao 叫较教交角脚焦骄胶郊浇娇椒礁矫狡绞侥搅缴蕉轿較腳驕膠澆嬌矯絞攪繳轎
+jie 结界接节解街姐阶介借届洁戒揭截杰劫皆竭捷诫睫結節階屆潔傑誡
+jin 进金今近尽仅紧禁斤劲锦晋津筋巾浸谨襟進盡僅緊勁錦謹
+jing 经京精境竟静景警镜井净晶径惊敬竞颈睛鲸荆經靜鏡淨徑驚競頸鯨
+jiong 窘炯迥
+jiu 就九旧究久救酒纠揪臼舅韭舊糾
+ju 局举具剧据句居巨拒聚距菊鞠拘驹矩沮炬锯舉劇據懼駒鋸
+juan 卷捐娟倦眷绢捲絹
+jue 觉决绝掘诀厥爵嚼倔覺決絕訣
+jun 军均君俊菌峻钧骏軍鈞駿
+ka 卡咖喀
+kai 开凯慨楷揩開凱
+kan 看刊堪砍侃坎勘龛
+kang 康抗扛炕慷亢糠
+kao 考靠烤拷
+ke 可科克客刻课颗渴壳柯棵磕苛坷課顆殼
+ken 肯恳啃垦懇墾
+keng 坑吭铿
+kong 空控孔恐
+kou 口扣寇叩
+ku 苦库哭酷裤枯窟庫褲
+kua 夸跨垮挎誇
+kuai 快块筷塊
+kuan 宽款寬
+kuang 况矿狂框旷眶筐況礦曠
+kui 亏愧溃馈窥葵奎魁盔傀虧潰饋窺
+kun 困昆坤捆睏
+kuo 扩括阔廓擴闊
+la 拉啦辣蜡腊喇垃蠟臘
+lai 来赖莱來賴
+lan 蓝兰烂拦栏懒览篮澜滥揽缆婪藍蘭爛攔欄懶覽籃
+lang 浪狼郎朗廊琅榔
+lao 老劳牢捞姥涝烙勞撈
+le 了乐勒樂
+lei 类累雷泪垒磊蕾肋擂類淚壘
+leng 冷棱愣
+li 里理力利立李历例离丽礼粒黎厉励莉璃哩篱梨隶狸犁吏俐裡裏歷離麗禮厲勵籬隸
+lia 俩倆
+lian 连联练脸莲恋廉链炼怜帘镰連聯練臉蓮戀鏈煉憐簾
+liang 两量良亮辆凉粮梁粱晾谅兩輛涼糧諒
+liao 料疗聊辽寥僚潦療遼
+lie 列烈裂猎劣咧獵
+lin 林临邻淋琳磷鳞吝凛臨鄰鱗
+ling 领令另零灵龄铃玲凌陵岭菱伶羚領靈齡鈴嶺
+liu 六流留刘柳溜硫瘤琉劉
+long 龙隆笼聋垄拢胧龍籠聾壟攏
+lou 楼漏搂娄陋樓摟
+lu 路陆录露鲁炉卢芦鹿碌禄颅虏庐陸錄魯爐盧蘆顱虜廬
+lv 律绿旅率虑驴铝屡履滤侣綠慮驢鋁屢濾侶
+luan 乱卵峦亂巒
+lve 略掠
+lun 论轮伦沦仑論輪倫淪
+luo 落罗络逻洛骆锣萝裸螺羅絡邏駱鑼蘿
+ma 马吗妈麻码骂嘛玛蚂馬嗎媽碼罵瑪螞
+mai 买卖麦迈埋脉買賣麥邁
+man 满慢漫曼蛮瞒馒蔓滿蠻瞞饅
+mang 忙芒盲茫莽氓
+mao 毛猫冒貌贸帽矛茂锚卯貿錨貓
+me 么麼麽
+mei 没美每妹梅媒煤眉霉枚玫魅沒
+men 们门闷們門悶
+meng 梦蒙猛盟孟萌朦夢
+mi 米密迷秘蜜眯弥谜觅靡彌謎覓
+mian 面免棉眠绵勉缅冕麵綿緬
+miao 秒苗描妙庙喵渺藐廟
+mie 灭蔑滅
+min 民敏闽抿悯皿閩憫
+ming 明名命鸣铭冥鳴銘
+miu 谬謬
+mo 模磨末摸默莫墨漠膜魔抹陌沫寞谟
+mou 某谋眸牟謀
+mu 目母木幕慕牧墓暮穆亩募沐拇畝
+na 那拿哪纳娜钠呐納
+nai 乃奶耐奈氖
+nan 南难男楠喃難
+nang 囊
+nao 脑闹恼挠瑙腦鬧惱撓
+ne 呢
+nei 内內
+nen 嫩
+neng 能
+ni 你尼泥逆拟匿腻妮倪昵溺擬膩
+nian 年念粘碾撵蔫
+niang 娘酿釀
+niao 鸟尿鳥
+nie 捏聂涅孽镊聶
+nin 您
+ning 宁凝拧柠狞寧擰檸
+niu 牛扭纽钮妞紐鈕
+nong 农弄浓脓農濃
+nu 努怒奴
+nv 女
+nuan 暖
+nve 虐疟瘧
+nuo 诺挪懦糯諾
+o 哦噢
+ou 欧偶呕殴鸥藕歐嘔毆鷗
+pa 怕爬帕趴啪琶
+pai 派排拍牌徘湃
+pan 盘判盼攀潘叛畔磐盤
+pang 旁胖庞乓耪龐
+pao 跑炮泡抛袍刨咆拋
+pei 配陪培赔佩沛胚賠
+pen 喷盆噴
+peng 朋碰彭鹏棚蓬膨捧烹篷澎鵬
+pi 批皮披匹疲脾啤劈僻屁譬辟琵痞
+pian 片篇偏骗翩騙
+piao 票漂飘瓢嫖飄
+pie 撇瞥
+pin 品贫拼频聘貧頻
+ping 平评凭瓶苹屏萍坪評憑蘋
+po 破婆迫坡颇泼魄頗潑
+pou 剖
+pu 普铺扑朴谱浦葡蒲仆瀑圃埔譜撲僕
+qi 起其期气七器汽奇企齐启骑旗棋妻弃欺漆岂歧祈乞凄栖沏砌迄泣崎琪氣齊啟騎棄豈
+qia 恰洽掐
+qian 前钱千签浅欠牵潜迁谦铅遣谴嵌歉乾錢簽淺牽潛遷謙鉛譴
+qiang 强枪墙抢腔羌蔷強槍牆搶
+qiao 桥巧敲悄乔侨瞧翘窍俏锹橋喬僑翹竅
+qie 切且窃怯茄竊
+qin 亲琴侵勤秦钦禽寝芹擒沁親欽寢
+qing 情清请青轻庆晴倾顷擎卿氢請輕慶傾頃氫
+qiong 穷琼窮瓊
+qiu 求球秋丘囚酋邱裘
+qu 去取区曲趣屈驱渠娶躯蛆區驅軀
+quan 全权劝泉圈券拳犬痊權勸
+que 却确缺雀鹊瘸卻確鵲
+qun 群裙
+ran 然燃染冉
+rang 让嚷壤攘瓤讓
+rao 绕扰饶繞擾饒
+re 热惹熱
+ren 人认任仁忍刃韧纫認韌
+reng 仍扔
+ri 日
+rong 容荣融溶绒蓉熔戎榕榮絨
+rou 肉柔揉蹂
+ru 如入乳辱儒汝褥
+ruan 软阮軟
+rui 瑞锐蕊睿銳
+run 润闰潤閏
+ruo 若弱偌
+sa 撒洒萨卅灑薩
+sai 赛塞腮鳃賽
+san 三散伞叁傘
+sang 桑丧嗓喪
+sao 扫嫂骚搔掃騷
+se 色涩瑟澀
+sen 森
+seng 僧
+sha 杀沙啥傻纱刹砂鲨煞殺紗鯊
+shai 晒筛曬篩
+shan 山善闪衫扇陕杉珊删擅膳汕閃陝刪
+shang 上商伤尚赏裳晌傷賞
+shao 少烧绍稍哨勺韶燒紹
+she 社设射舍涉蛇舌摄奢赦設攝
+shei 谁誰
+shen 身深神什审甚申伸慎肾渗绅呻娠沈審腎滲紳
+sheng 生声胜省升绳圣盛剩牲甥聲勝繩聖
+shi 是时事十市使世实式始史室师识试石士施失示食视适势诗释湿拾氏饰驶逝誓狮蚀矢柿侍嗜時實師識試視適勢詩釋濕飾駛獅蝕
+shou 手受收首守售授寿瘦兽壽獸
+shu 书数术树属输述熟鼠束署舒殊叔疏蔬竖淑暑薯蜀梳漱恕墅枢書數術樹屬輸豎樞
+shua 刷耍
+shuai 帅衰摔甩帥
+shuan 拴涮栓
+shuang 双爽霜雙
+shui 水税睡稅
+shun 顺瞬舜順
+shuo 说硕朔烁說碩爍
+si 四思死司私斯丝似寺撕肆嘶饲祀絲飼
+song 送松宋颂诵耸讼頌誦聳訟
+sou 搜艘嗽擞
+su 素速苏诉俗塑肃宿酥粟溯蘇訴肅
+suan 算酸蒜
+sui 随岁虽碎遂穗隧髓祟隨歲雖
+sun 孙损笋孫損筍
+suo 所索锁缩琐唆梭嗦鎖縮瑣
+ta 他她它塔踏塌獭
+tai 台太态泰抬胎汰苔態颱臺
+tan 谈探坦弹叹摊滩贪坛毯碳痰瘫潭檀談歎攤灘貪壇癱
+tang 堂唐糖汤躺趟塘倘膛棠烫湯燙
+tao 套讨逃桃陶涛淘掏滔萄討濤
+te 特忑
+teng 腾疼藤誊騰
+ti 题体提替梯踢蹄啼剔惕題體
+tian 天田添填甜恬舔
+tiao 条跳挑迢眺條
+tie 铁贴帖鐵貼
+ting 听厅停庭挺亭艇婷廷聽廳
+tong 同通统痛童铜筒桶捅彤瞳統銅
+tou 头投透偷頭
+tu 图土突途徒涂吐兔屠秃圖塗禿
+tuan 团湍團
+tui 推退腿颓褪頹
+tun 吞屯囤臀
+tuo 托脱拖妥拓驼陀唾椭託脫駝
+wa 挖哇娃瓦蛙袜襪
+wai 外歪
+wan 万完晚玩湾弯碗顽挽婉丸皖宛萬灣彎頑
+wang 王网往望忘旺亡汪妄枉網
+wei 为位委未维卫危围伟微味威尾谓唯违韦魏慰胃喂伪惟畏纬蔚為維衛圍偉謂違韋偽緯
+wen 文问闻温稳吻纹蚊紊問聞溫穩紋
+weng 翁嗡瓮
+wo 我握卧窝沃蜗臥窩
+wu 无五物务武午舞屋误吴污乌悟伍雾勿吾巫侮捂梧誤無務吳烏霧
+xi 西系息希习细喜洗戏席吸析袭悉稀溪锡熙惜夕嬉膝昔犀晰隙熄兮羲習細戲襲錫
+xia 下夏吓峡侠霞虾瞎辖狭暇厦嚇峽俠蝦轄狹
+xian 现先线限显县险鲜闲献贤宪弦仙纤嫌陷衔咸掀羡腺現線顯縣險鮮閑獻賢憲纖銜
+xiang 想向相象香项响乡详箱享祥巷翔橡镶項響鄉詳鑲
+xiao 小笑校效消晓销肖削孝萧啸宵潇曉銷蕭嘯瀟
+xie 写些谢鞋协斜携泄械胁卸谐歇屑蟹邪寫謝協攜脅諧
+xin 心新信欣辛薪芯锌馨
+xing 行性星型形兴幸姓醒刑杏腥興
+xiong 兄雄胸凶熊汹
+xiu 修休秀袖绣羞朽嗅锈繡銹
+xu 需许续须序虚徐蓄叙絮婿旭绪酗許續須虛敘緒
+xuan 选宣悬旋玄轩喧炫癣選懸軒
+xue 学雪穴靴學血
+xun 讯寻训迅询巡循旬逊熏殉訊尋訓詢遜
+ya 呀压牙亚雅鸭押芽崖哑讶涯蚜壓亞鴨啞訝
+yan 眼言严研演验烟沿颜延岩炎燕盐掩艳宴厌焰雁咽淹衍砚嫣嚴驗煙顏鹽豔厭硯
+yang 样阳羊养洋扬央仰氧杨秧痒漾樣陽養揚楊癢
+yao 要药摇腰咬邀遥窑谣耀姚妖舀藥搖遙窯謠
+ye 也业夜野叶页爷液冶椰耶噎業葉頁爺
+yi 一以已意义医议易衣依益移艺亿异忆疑仪遗宜姨谊译抑翼椅役疫毅逸伊倚乙彝亦奕溢肄裔矣揖沂義醫議藝億異憶儀遺誼譯
+yin 因音引银印阴饮隐姻吟尹寅淫殷蚓銀陰飲隱
+ying 应影英营迎硬赢映鹰婴樱颖盈莹荧蝇應營贏鷹嬰櫻穎瑩螢蠅
+yo 哟喲
+yong 用永勇拥涌庸泳咏踊佣雍擁詠踴傭
+you 有又由友油游右优尤邮犹忧幽悠诱幼佑釉優郵猶憂誘
+yu 于与语育遇雨余鱼玉域预欲愈宇羽狱誉愉浴渔娱榆裕虞愚逾寓隅喻御於與語魚預獄譽漁娛
+yuan 元原员院远园愿圆源援缘怨苑渊袁猿辕員遠園願圓緣淵轅
+yue 月越约阅跃岳粤悦約閱躍嶽粵
+yun 云运允韵孕晕蕴匀耘雲運韻暈蘊
+za 杂砸咋雜
+zai 在再载灾栽宰哉災載
+zan 咱赞暂攒讚贊暫
+zang 脏葬赃臟髒
+zao 早造遭糟燥澡枣噪灶躁皂凿棗竈
+ze 则责择泽仄則責擇澤
+zei 贼賊
+zen 怎
+zeng 增赠憎贈
+zha 扎炸闸眨渣榨乍诈栅閘詐
+zhai 宅窄债寨摘斋債齋
+zhan 站展战占沾斩盏瞻崭栈绽戰斬盞嶄棧綻
+zhang 张章掌丈仗账帐障涨杖彰漳張帳賬漲
+zhao 找照招赵召兆沼罩肇昭趙
+zhe 这這者着哲折遮浙蔗這
+zhen 真阵针镇震珍诊枕振侦斟陣針鎮診偵
+zheng 正政争整证征症郑挣蒸睁怔爭證徵鄭
+zhi 之只知制治至指直支质职值止纸志执植致智置织旨枝址殖秩滞稚汁掷芝脂肢蜘隻質職紙執織滯擲
+zhong 中种重众终钟忠肿仲衷種眾終鐘腫
+zhou 周州洲轴皱粥舟宙骤昼咒週軸皺驟晝
+zhu 主住注助著竹祝猪朱珠筑逐驻株烛铸嘱诸煮拄蛛柱註豬築駐燭鑄囑諸
+zhua 抓爪
+zhuai 拽
+zhuan 转专砖撰篆轉專磚
+zhuang 状装庄壮撞妆桩狀裝莊壯妝樁
+zhui 追坠缀锥赘墜綴錐贅
+zhun 准谆準
+zhuo 桌捉卓浊灼酌琢啄濁
+zi 子自字资紫姿滋仔籽咨兹姊資
+zong 总宗综纵棕踪鬃總綜縱蹤
+zou 走奏揍邹鄒
+zu 组族足祖租阻卒诅組詛
+zuan 钻纂鑽
+zui 最罪嘴醉
+zun 尊遵樽
+zuo 作做座左坐昨佐`
+
+// pinyinPhraseData contains phrases where a character has a reading different from the one in
+// pinyinCharacterData, each line is a phrase followed by the syllables.
+const pinyinPhraseData = `银行 yin hang
+行业 hang ye
+行长 hang zhang
+同行 tong hang
+长大 zhang da
+校长 xiao zhang
+市长 shi zhang
+部长 bu zhang
+成长 cheng zhang
+生长 sheng zhang
+家长 jia zhang
+增长 zeng zhang
+重庆 chong qing
+重复 chong fu
+重新 chong xin
+音乐 yin yue
+乐器 yue qi
+睡觉 shui jiao
+还钱 huan qian
+归还 gui huan
+首都 shou du
+成都 cheng du
+都市 du shi
+朝阳 zhao yang
+朝鲜 chao xian
+会计 kuai ji
+会稽 kuai ji
+子弹 zi dan
+弹药 dan yao
+炸弹 zha dan
+导弹 dao dan
+大厦 da sha
+厦门 xia men
+得到 de dao
+觉得 jue de
+了解 liao jie
+了不起 liao bu qi
+为了 wei le
+因为 yin wei
+为什么 wei shen me
+什么 shen me
+地方 di fang
+目的 mu di
+的确 di que
+差不多 cha bu duo
+出差 chu chai
+参差 cen ci
+人参 ren shen
+数学 shu xue
+数据 shu ju
+空气 kong qi
+空间 kong jian
+便宜 pian yi
+单于 chan yu
+传记 zhuan ji
+自传 zi zhuan
+调查 diao cha
+调整 tiao zheng
+空调 kong tiao
+着急 zhao ji
+睡着 shui zhao
+快乐 kuai le
+乐观 le guan
+和平 he ping
+暖和 nuan huo
+薄荷 bo he
+银行家 yin hang jia
+北京 bei jing
+南京 nan jing
+中国 zhong guo
+上海 shang hai
+广州 guang zhou
+深圳 shen zhen
+香港 xiang gang
+台湾 tai wan
+臺灣 tai wan
+台北 tai bei
+西藏 xi zang
+宝藏 bao zang
+藏族 zang zu
+长城 chang cheng
+长江 chang jiang
+曾经 ceng jing
+曾国藩 zeng guo fan
+单位 dan wei
+沈阳 shen yang
+朴素 pu su
+蚌埠 beng bu
+六安 lu an
+种植 zhong zhi
+种类 zhong lei
+重要 zhong yao
+省略 sheng lve
+反省 fan xing
+角色 jue se
+口角 kou jue
+血液 xue ye
+流血 liu xue
+系统 xi tong
+关系 guan xi
+系鞋带 ji xie dai
+似的 shi de
+好像 hao xiang
+爱好 ai hao
+好奇 hao qi
+背包 bei bao
+背着 bei zhe
+处理 chu li
+到处 dao chu
+处长 chu zhang
+应该 ying gai
+答应 da ying
+相信 xiang xin
+照相 zhao xiang
+首相 shou xiang
+扇子 shan zi
+模样 mu yang
+模型 mo xing
+一模一样 yi mu yi yang
+尽管 jin guan
+尽量 jin liang
+露面 lou mian
+露天 lu tian
+率领 shuai ling
+效率 xiao lv
+大夫 dai fu
+大王 dai wang
+骨头 gu tou
+结果 jie guo
+结实 jie shi
+呢子 ni zi
+哪里 na li
+那里 na li
+音乐会 yin yue hui
+还是 hai shi
+还有 hai you
+牛仔 niu zai`
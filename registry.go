@@ -0,0 +1,287 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goslugify
+
+import (
+	"sort"
+	"sync"
+)
+
+// LanguageRegistry stores the language specific replace maps, word maps, transliterators and stop words, the
+// languages are BCP 47 language tags (see LanguageFallbacks).
+//
+// A registry is safe for concurrent use, languages can be registered while slugs are generated.
+// Note however that a SlugGenerator looks up its languages once in SlugConfig.Configure, changes to
+// the registry afterwards only affect generators configured later.
+// The registry doesn't copy the maps, so a map must not be changed after it has been added.
+//
+// The global functions (AddLanguageMap, GetLanguageMap etc.) use DefaultLanguageRegistry, set
+// SlugConfig.Registry to use a different registry, for example to isolate tests or tenants.
+type LanguageRegistry struct {
+	mutex           sync.RWMutex
+	maps            map[string]StringReplaceMap
+	words           map[string]StringReplaceMap
+	transliterators map[string]StringModifierFunc
+	stopWords       map[string]StopWords
+}
+
+// NewLanguageRegistry returns a new registry without any languages.
+func NewLanguageRegistry() *LanguageRegistry {
+	return &LanguageRegistry{
+		maps:            make(map[string]StringReplaceMap),
+		words:           make(map[string]StringReplaceMap),
+		transliterators: make(map[string]StringModifierFunc),
+		stopWords:       make(map[string]StopWords),
+	}
+}
+
+// NewDefaultLanguageRegistry returns a new registry that contains the built-in languages, see GetLanguageMap,
+// GetLanguageTransliterator and GetStopWords.
+func NewDefaultLanguageRegistry() *LanguageRegistry {
+	registry := NewLanguageRegistry()
+	registry.addBuiltinLanguages()
+	return registry
+}
+
+// DefaultLanguageRegistry is the registry used by the global functions and by a SlugConfig without a
+// Registry, it contains the built-in languages.
+var DefaultLanguageRegistry = NewDefaultLanguageRegistry()
+
+// Clone returns a copy of the registry, changes to the copy don't affect the original registry
+// (and vice versa).
+func (registry *LanguageRegistry) Clone() *LanguageRegistry {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+	res := &LanguageRegistry{
+		maps:            make(map[string]StringReplaceMap, len(registry.maps)),
+		words:           make(map[string]StringReplaceMap, len(registry.words)),
+		transliterators: make(map[string]StringModifierFunc, len(registry.transliterators)),
+		stopWords:       make(map[string]StopWords, len(registry.stopWords)),
+	}
+	for key, m := range registry.maps {
+		res.maps[key] = m
+	}
+	for key, m := range registry.words {
+		res.words[key] = m
+	}
+	for key, f := range registry.transliterators {
+		res.transliterators[key] = f
+	}
+	for key, words := range registry.stopWords {
+		res.stopWords[key] = words
+	}
+	return res
+}
+
+// AddMap adds the replace map of a language, an existing map of this language is replaced.
+//
+// language is a BCP 47 language tag, regional variants can be registered as well: A map for "de-AT" is used
+// for "de-AT" (and "de-AT-..."), the entries of "de" are used as a fallback.
+func (registry *LanguageRegistry) AddMap(language string, m StringReplaceMap) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	registry.maps[languageKey(language)] = m
+}
+
+// ExtendMap adds the entries of m to the replace map of a language, entries of m replace existing entries.
+// The existing map is not changed, a new merged map is stored instead.
+func (registry *LanguageRegistry) ExtendMap(language string, m StringReplaceMap) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	key := languageKey(language)
+	registry.maps[key] = MergeStringReplaceMaps(m, registry.maps[key])
+}
+
+// RemoveMap removes the replace map of a language, it returns false if the language doesn't have a map.
+func (registry *LanguageRegistry) RemoveMap(language string) bool {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	key := languageKey(language)
+	_, has := registry.maps[key]
+	delete(registry.maps, key)
+	return has
+}
+
+// AddWords adds the word map of a language, an existing word map of this language is replaced.
+// In contrast to the replace map only complete words are replaced and the case is ignored, see
+// NewWordReplaceFunc. The keys of m must be lower case.
+// language is a BCP 47 language tag, see AddMap.
+func (registry *LanguageRegistry) AddWords(language string, m StringReplaceMap) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	registry.words[languageKey(language)] = m
+}
+
+// RemoveWords removes the word map of a language, it returns false if the language doesn't have a word map.
+func (registry *LanguageRegistry) RemoveWords(language string) bool {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	key := languageKey(language)
+	_, has := registry.words[key]
+	delete(registry.words, key)
+	return has
+}
+
+// AddTransliterator adds the transliterator of a language, an existing transliterator of this language is
+// replaced. language is a BCP 47 language tag, see AddMap.
+func (registry *LanguageRegistry) AddTransliterator(language string, f StringModifierFunc) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	registry.transliterators[languageKey(language)] = f
+}
+
+// RemoveTransliterator removes the transliterator of a language, it returns false if the language doesn't
+// have a transliterator.
+func (registry *LanguageRegistry) RemoveTransliterator(language string) bool {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	key := languageKey(language)
+	_, has := registry.transliterators[key]
+	delete(registry.transliterators, key)
+	return has
+}
+
+// AddStopWords adds the stop words of a language, existing stop words of this language are replaced.
+// language is a BCP 47 language tag, see AddMap.
+func (registry *LanguageRegistry) AddStopWords(language string, words StopWords) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	registry.stopWords[languageKey(language)] = words
+}
+
+// RemoveStopWords removes the stop words of a language, it returns false if the language doesn't have stop
+// words.
+func (registry *LanguageRegistry) RemoveStopWords(language string) bool {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	key := languageKey(language)
+	_, has := registry.stopWords[key]
+	delete(registry.stopWords, key)
+	return has
+}
+
+// Remove removes the replace map, the word map, the transliterator and the stop words of a language, it
+// returns false if the language doesn't exist.
+func (registry *LanguageRegistry) Remove(language string) bool {
+	removedMap := registry.RemoveMap(language)
+	removedWords := registry.RemoveWords(language)
+	removedTransliterator := registry.RemoveTransliterator(language)
+	removedStopWords := registry.RemoveStopWords(language)
+	return removedMap || removedWords || removedTransliterator || removedStopWords
+}
+
+// Languages returns all languages (with a replace map, a word map, a transliterator or stop words) in
+// canonical form, sorted alphabetically.
+func (registry *LanguageRegistry) Languages() []string {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+	keys := make(map[string]bool, len(registry.maps))
+	for key := range registry.maps {
+		keys[key] = true
+	}
+	for key := range registry.words {
+		keys[key] = true
+	}
+	for key := range registry.transliterators {
+		keys[key] = true
+	}
+	for key := range registry.stopWords {
+		keys[key] = true
+	}
+	res := make([]string, 0, len(keys))
+	for key := range keys {
+		res = append(res, key)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// GetMap returns a StringReplaceMap for a given list of languages (BCP 47 language tags).
+// All maps for the specific languages are merged with MergeStringReplaceMaps.
+// For each language the maps of its fallback chain (see LanguageFallbacks) are used, the more specific maps
+// come first: "de-CH" uses the entries of "de-CH" and then the entries of "de".
+// If a language doesn't exist the entry will be ignored.
+func (registry *LanguageRegistry) GetMap(languages ...string) StringReplaceMap {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+	mapList := make([]StringReplaceMap, 0, len(languages))
+	for _, l := range languages {
+		for _, fallback := range LanguageFallbacks(l) {
+			if m, has := registry.maps[fallback]; has {
+				mapList = append(mapList, m)
+			}
+		}
+	}
+	return MergeStringReplaceMaps(mapList...)
+}
+
+// GetWords returns the word map (see AddWords) for a given list of languages (BCP 47 language tags), the maps
+// are merged as in GetMap: "de-AT" uses the entries of "de-AT" and then the entries of "de".
+// If a language doesn't exist the entry will be ignored.
+func (registry *LanguageRegistry) GetWords(languages ...string) StringReplaceMap {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+	mapList := make([]StringReplaceMap, 0, len(languages))
+	for _, l := range languages {
+		for _, fallback := range LanguageFallbacks(l) {
+			if m, has := registry.words[fallback]; has {
+				mapList = append(mapList, m)
+			}
+		}
+	}
+	return MergeStringReplaceMaps(mapList...)
+}
+
+// getTransliterators returns for each language the most specific transliterator of its fallback chain.
+func (registry *LanguageRegistry) getTransliterators(languages ...string) []StringModifierFunc {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+	res := make([]StringModifierFunc, 0, len(languages))
+	for _, l := range languages {
+		for _, fallback := range LanguageFallbacks(l) {
+			if f, has := registry.transliterators[fallback]; has {
+				res = append(res, f)
+				break
+			}
+		}
+	}
+	return res
+}
+
+// GetTransliterator returns a StringModifierFunc that applies the transliterators of all given languages
+// (in the given order).
+// For each language the most specific transliterator of its fallback chain is used (see LanguageFallbacks),
+// thus "ru-RU" uses the transliterator of "ru".
+// If a language doesn't have a transliterator the entry will be ignored.
+func (registry *LanguageRegistry) GetTransliterator(languages ...string) StringModifierFunc {
+	return ChainStringModifierFuncs(registry.getTransliterators(languages...)...)
+}
+
+// GetStopWords returns the stop words of the given languages, the stop words of the fallback chain of each
+// language (see LanguageFallbacks) are included.
+// If a language doesn't have stop words the entry will be ignored.
+func (registry *LanguageRegistry) GetStopWords(languages ...string) StopWords {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+	sets := make([]StopWords, 0, len(languages))
+	for _, l := range languages {
+		for _, fallback := range LanguageFallbacks(l) {
+			if words, has := registry.stopWords[fallback]; has {
+				sets = append(sets, words)
+			}
+		}
+	}
+	return MergeStopWords(sets...)
+}
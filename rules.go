@@ -0,0 +1,970 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goslugify
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"golang.org/x/text/unicode/norm"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// RuleSyntaxError is returned if transform rules (see ParseTransformRules) are not valid.
+// Line is the line in the rules where the error occurred.
+type RuleSyntaxError struct {
+	Line    int
+	Message string
+}
+
+func (err *RuleSyntaxError) Error() string {
+	return fmt.Sprintf("invalid transform rules in line %d: %s", err.Line, err.Message)
+}
+
+// TransformResolver returns the transform for an ID used in a "::ID ;" directive, for example "Latin-ASCII".
+// See NewFSTransformResolver for a resolver that loads the rules from files.
+type TransformResolver func(id string) (StringModifierFunc, error)
+
+// ruleElem is a single rune or a set of runes in a rule.
+type ruleElem struct {
+	r   rune
+	set runeSet
+}
+
+func (elem ruleElem) matches(r rune) bool {
+	if elem.set != nil {
+		return elem.set(r)
+	}
+	return elem.r == r
+}
+
+// conversionRule is a rule "before { key } after > output", the cursor is the position in the output where
+// the transliteration continues.
+type conversionRule struct {
+	before, key, after     []ruleElem
+	anchorStart, anchorEnd bool
+	output                 []rune
+	cursor                 int
+}
+
+func matchElems(elems []ruleElem, text []rune, pos int) bool {
+	if pos < 0 || pos+len(elems) > len(text) {
+		return false
+	}
+	for i, elem := range elems {
+		if !elem.matches(text[pos+i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// match tests if the rule matches at pos and returns the end of the key.
+func (rule *conversionRule) match(text []rune, pos int) (int, bool) {
+	end := pos + len(rule.key)
+	if !matchElems(rule.key, text, pos) || !matchElems(rule.before, text, pos-len(rule.before)) ||
+		!matchElems(rule.after, text, end) {
+		return 0, false
+	}
+	if rule.anchorStart && pos-len(rule.before) != 0 {
+		return 0, false
+	}
+	if rule.anchorEnd && end+len(rule.after) != len(text) {
+		return 0, false
+	}
+	return end, true
+}
+
+// maxRuleStalls is the number of times rules may be applied without advancing the position (if the cursor
+// is placed at the beginning of the output) and without making the text shorter, after that the position is
+// advanced to avoid endless loops (for example with the rules "a > b ; b > a ;").
+// Rules that delete text are not counted, they can't loop forever.
+const maxRuleStalls = 16
+
+func applyRules(rules []conversionRule, text []rune) []rune {
+	buf := make([]rune, len(text))
+	copy(buf, text)
+	stalls := 0
+	for pos := 0; pos < len(buf); {
+		applied := false
+		for i := range rules {
+			rule := &rules[i]
+			end, ok := rule.match(buf, pos)
+			if !ok {
+				continue
+			}
+			replaced := make([]rune, 0, len(buf)-(end-pos)+len(rule.output))
+			replaced = append(replaced, buf[:pos]...)
+			replaced = append(replaced, rule.output...)
+			buf = append(replaced, buf[end:]...)
+			if rule.cursor > 0 {
+				pos += rule.cursor
+				stalls = 0
+			} else if len(rule.output) >= end-pos {
+				// the text didn't get shorter
+				if stalls++; stalls >= maxRuleStalls {
+					pos++
+					stalls = 0
+				}
+			}
+			applied = true
+			break
+		}
+		if !applied {
+			pos++
+			stalls = 0
+		}
+	}
+	return buf
+}
+
+// applyFiltered applies f to all runs of runes that are contained in filter, the other runes are not changed.
+// If filter is nil f is applied to the whole text.
+func applyFiltered(text []rune, filter runeSet, f func(run []rune) []rune) []rune {
+	if filter == nil {
+		return f(text)
+	}
+	res := make([]rune, 0, len(text))
+	start := -1
+	for i, r := range text {
+		if filter(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			res = append(res, f(text[start:i])...)
+			start = -1
+		}
+		res = append(res, r)
+	}
+	if start >= 0 {
+		res = append(res, f(text[start:])...)
+	}
+	return res
+}
+
+// rulePhase is either a list of conversion rules or a transform from a directive.
+type rulePhase struct {
+	filter    runeSet
+	rules     []conversionRule
+	transform StringModifierFunc
+}
+
+func (phase *rulePhase) apply(run []rune) []rune {
+	if phase.transform != nil {
+		return []rune(phase.transform(string(run)))
+	}
+	return applyRules(phase.rules, run)
+}
+
+// RuleTransliterator is a StringModifier that applies transform rules, see ParseTransformRules.
+type RuleTransliterator struct {
+	filter runeSet
+	phases []rulePhase
+}
+
+// Modify implements the StringModifier interface.
+func (transliterator *RuleTransliterator) Modify(in string) string {
+	return string(applyFiltered([]rune(in), transliterator.filter, func(run []rune) []rune {
+		for i := range transliterator.phases {
+			phase := &transliterator.phases[i]
+			run = applyFiltered(run, phase.filter, phase.apply)
+		}
+		return run
+	}))
+}
+
+// builtinTransform returns the transforms that are always available ("Any-" is optional): "NFD", "NFC",
+// "NFKD", "NFKC", "Lower", "Upper", "Null" and "Remove".
+func builtinTransform(id string) (StringModifierFunc, bool) {
+	switch strings.TrimPrefix(strings.ToLower(id), "any-") {
+	case "nfd":
+		return ToStringHandleFunc(NewUTF8Normalizer(norm.NFD)), true
+	case "nfc":
+		return ToStringHandleFunc(NewUTF8Normalizer(norm.NFC)), true
+	case "nfkd":
+		return ToStringHandleFunc(NewUTF8Normalizer(norm.NFKD)), true
+	case "nfkc":
+		return ToStringHandleFunc(NewUTF8Normalizer(norm.NFKC)), true
+	case "lower":
+		return strings.ToLower, true
+	case "upper":
+		return strings.ToUpper, true
+	case "null":
+		return func(in string) string { return in }, true
+	case "remove":
+		return func(in string) string { return "" }, true
+	}
+	return nil, false
+}
+
+// ruleToken is an element (rune or set) or an operator of a rule.
+type ruleToken struct {
+	op   string
+	elem ruleElem
+}
+
+// ruleScanner reads the tokens of a single statement.
+type ruleScanner struct {
+	runes     []rune
+	pos       int
+	line      int
+	variables map[string][]ruleElem
+}
+
+func (scanner *ruleScanner) errorf(format string, args ...interface{}) error {
+	line := scanner.line
+	for _, r := range scanner.runes[:scanner.pos] {
+		if r == '\n' {
+			line++
+		}
+	}
+	return &RuleSyntaxError{Line: line, Message: fmt.Sprintf(format, args...)}
+}
+
+func (scanner *ruleScanner) done() bool {
+	return scanner.pos >= len(scanner.runes)
+}
+
+func (scanner *ruleScanner) hasPrefix(prefix string) bool {
+	return strings.HasPrefix(string(scanner.runes[scanner.pos:]), prefix)
+}
+
+func (scanner *ruleScanner) skipSpace() {
+	for !scanner.done() && unicode.IsSpace(scanner.runes[scanner.pos]) {
+		scanner.pos++
+	}
+}
+
+func (scanner *ruleScanner) atSet() bool {
+	return scanner.hasPrefix("[") || scanner.hasPrefix(`\p`) || scanner.hasPrefix(`\P`)
+}
+
+func isVariableRune(r rune, first bool) bool {
+	return r == '_' || unicode.IsLetter(r) || (!first && unicode.IsDigit(r))
+}
+
+// readVariable reads a variable reference "$name" and returns its value.
+func (scanner *ruleScanner) readVariable() ([]ruleElem, error) {
+	scanner.pos++
+	start := scanner.pos
+	for !scanner.done() && isVariableRune(scanner.runes[scanner.pos], scanner.pos == start) {
+		scanner.pos++
+	}
+	name := string(scanner.runes[start:scanner.pos])
+	value, has := scanner.variables[name]
+	if !has {
+		return nil, scanner.errorf("undefined variable $%s", name)
+	}
+	return value, nil
+}
+
+// readEscape reads an escape sequence starting with a backslash.
+func (scanner *ruleScanner) readEscape() (rune, error) {
+	scanner.pos++
+	if scanner.done() {
+		return 0, scanner.errorf("incomplete escape sequence")
+	}
+	r := scanner.runes[scanner.pos]
+	scanner.pos++
+	digits := 0
+	switch r {
+	case 'u':
+		digits = 4
+	case 'U':
+		digits = 8
+	case 'x':
+		digits = 2
+		if scanner.hasPrefix("{") {
+			rest := string(scanner.runes[scanner.pos+1:])
+			end := strings.IndexRune(rest, '}')
+			if end < 0 {
+				return 0, scanner.errorf("incomplete escape sequence")
+			}
+			scanner.pos += len([]rune(rest[:end])) + 2
+			return scanner.parseHex(rest[:end])
+		}
+	case 't':
+		return '\t', nil
+	case 'n':
+		return '\n', nil
+	case 'r':
+		return '\r', nil
+	default:
+		return r, nil
+	}
+	if scanner.pos+digits > len(scanner.runes) {
+		return 0, scanner.errorf("incomplete escape sequence")
+	}
+	scanner.pos += digits
+	return scanner.parseHex(string(scanner.runes[scanner.pos-digits : scanner.pos]))
+}
+
+func (scanner *ruleScanner) parseHex(s string) (rune, error) {
+	value, err := strconv.ParseUint(s, 16, 32)
+	if err != nil || value > unicode.MaxRune {
+		return 0, scanner.errorf("invalid escape sequence \"%s\"", s)
+	}
+	return rune(value), nil
+}
+
+// readQuoted reads a quoted string, two single quotes are read as a literal single quote.
+func (scanner *ruleScanner) readQuoted() ([]rune, error) {
+	scanner.pos++
+	if scanner.hasPrefix("'") {
+		scanner.pos++
+		return []rune{'\''}, nil
+	}
+	var res []rune
+	for {
+		if scanner.done() {
+			return nil, scanner.errorf("unterminated quote")
+		}
+		r := scanner.runes[scanner.pos]
+		scanner.pos++
+		if r != '\'' {
+			res = append(res, r)
+			continue
+		}
+		if scanner.hasPrefix("'") {
+			scanner.pos++
+			res = append(res, '\'')
+			continue
+		}
+		return res, nil
+	}
+}
+
+// readSetRune reads a single rune in a set (plain, escaped or quoted).
+func (scanner *ruleScanner) readSetRune() ([]rune, error) {
+	switch r := scanner.runes[scanner.pos]; r {
+	case '\\':
+		escaped, err := scanner.readEscape()
+		return []rune{escaped}, err
+	case '\'':
+		return scanner.readQuoted()
+	default:
+		scanner.pos++
+		return []rune{r}, nil
+	}
+}
+
+// readProperty reads a property name until end.
+func (scanner *ruleScanner) readProperty(end string, negate bool) (runeSet, error) {
+	index := strings.Index(string(scanner.runes[scanner.pos:]), end)
+	if index < 0 {
+		return nil, scanner.errorf("unterminated property")
+	}
+	name := string(scanner.runes[scanner.pos:])[:index]
+	scanner.pos += len([]rune(name)) + len(end)
+	if strings.HasPrefix(name, "^") {
+		negate, name = !negate, name[1:]
+	}
+	set, ok := lookupProperty(strings.TrimSpace(name))
+	if !ok {
+		return nil, scanner.errorf("unknown property \"%s\"", name)
+	}
+	if negate {
+		set = negateSet(set)
+	}
+	return set, nil
+}
+
+// readSet reads a set like "[a-z]", "[^[:Latin:]&[:Lu:]]" or "\p{Greek}".
+func (scanner *ruleScanner) readSet() (runeSet, error) {
+	switch {
+	case scanner.hasPrefix(`\p{`), scanner.hasPrefix(`\P{`):
+		negate := scanner.runes[scanner.pos+1] == 'P'
+		scanner.pos += 3
+		return scanner.readProperty("}", negate)
+	case scanner.hasPrefix("[:"):
+		scanner.pos += 2
+		return scanner.readProperty(":]", false)
+	}
+	scanner.pos++
+	negate := false
+	if scanner.hasPrefix("^") {
+		negate = true
+		scanner.pos++
+	}
+	builder := newSetBuilder()
+	var op rune
+	last := rune(-1)
+	for {
+		scanner.skipSpace()
+		if scanner.done() {
+			return nil, scanner.errorf("unterminated set")
+		}
+		switch r := scanner.runes[scanner.pos]; {
+		case r == ']':
+			scanner.pos++
+			set := builder.build()
+			if negate {
+				set = negateSet(set)
+			}
+			return set, nil
+		case scanner.atSet():
+			nested, err := scanner.readSet()
+			if err != nil {
+				return nil, err
+			}
+			if op != 0 {
+				builder.apply(op, nested)
+				op = 0
+			} else {
+				builder.addSet(nested)
+			}
+			last = -1
+		case r == '&':
+			op = '&'
+			scanner.pos++
+			last = -1
+		case r == '-':
+			scanner.pos++
+			scanner.skipSpace()
+			switch {
+			case scanner.done():
+				return nil, scanner.errorf("unterminated set")
+			case scanner.atSet():
+				// difference, the set is read in the next iteration
+				op = '-'
+			case last >= 0 && scanner.runes[scanner.pos] != ']':
+				to, err := scanner.readSetRune()
+				if err != nil {
+					return nil, err
+				}
+				if len(to) != 1 || to[0] < last {
+					return nil, scanner.errorf("invalid range in set")
+				}
+				builder.addRange(last, to[0])
+				last = -1
+			default:
+				builder.addRune('-')
+				last = '-'
+			}
+		case r == '$' && scanner.pos+1 < len(scanner.runes) && isVariableRune(scanner.runes[scanner.pos+1], true):
+			value, err := scanner.readVariable()
+			if err != nil {
+				return nil, err
+			}
+			for _, elem := range value {
+				if elem.set != nil {
+					builder.addSet(elem.set)
+				} else {
+					builder.addRune(elem.r)
+				}
+			}
+			last = -1
+		default:
+			runes, err := scanner.readSetRune()
+			if err != nil {
+				return nil, err
+			}
+			for _, r := range runes {
+				builder.addRune(r)
+				last = r
+			}
+		}
+	}
+}
+
+// tokens returns all tokens of the statement.
+func (scanner *ruleScanner) tokens() ([]ruleToken, error) {
+	var res []ruleToken
+	addRunes := func(runes ...rune) {
+		for _, r := range runes {
+			res = append(res, ruleToken{elem: ruleElem{r: r}})
+		}
+	}
+	for scanner.skipSpace(); !scanner.done(); scanner.skipSpace() {
+		r := scanner.runes[scanner.pos]
+		switch {
+		case r == '\'':
+			runes, err := scanner.readQuoted()
+			if err != nil {
+				return nil, err
+			}
+			addRunes(runes...)
+		case scanner.atSet():
+			set, err := scanner.readSet()
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, ruleToken{elem: ruleElem{set: set}})
+		case r == '\\':
+			escaped, err := scanner.readEscape()
+			if err != nil {
+				return nil, err
+			}
+			addRunes(escaped)
+		case r == '$' && scanner.pos+1 < len(scanner.runes) && isVariableRune(scanner.runes[scanner.pos+1], true):
+			value, err := scanner.readVariable()
+			if err != nil {
+				return nil, err
+			}
+			for _, elem := range value {
+				res = append(res, ruleToken{elem: elem})
+			}
+		case strings.ContainsRune("{}|^$=", r):
+			res = append(res, ruleToken{op: string(r)})
+			scanner.pos++
+		case r == '>' || r == '→':
+			res = append(res, ruleToken{op: ">"})
+			scanner.pos++
+		case r == '<' && scanner.hasPrefix("<>"):
+			res = append(res, ruleToken{op: "<>"})
+			scanner.pos += 2
+		case r == '↔':
+			res = append(res, ruleToken{op: "<>"})
+			scanner.pos++
+		case r == '<' || r == '←':
+			res = append(res, ruleToken{op: "<"})
+			scanner.pos++
+		case strings.ContainsRune("*+?()@", r):
+			return nil, scanner.errorf("\"%c\" is not supported", r)
+		default:
+			addRunes(r)
+			scanner.pos++
+		}
+	}
+	return res, nil
+}
+
+// ruleStatement is a statement (terminated by ";") and the line where it starts.
+type ruleStatement struct {
+	text []rune
+	line int
+}
+
+// splitRuleStatements splits the rules into statements and removes comments.
+func splitRuleStatements(rules string) ([]ruleStatement, error) {
+	var res []ruleStatement
+	var current []rune
+	line, start := 1, 1
+	inQuote := false
+	depth := 0
+	runes := []rune(rules)
+	add := func(r rune) {
+		if len(current) == 0 {
+			if unicode.IsSpace(r) {
+				return
+			}
+			start = line
+		}
+		current = append(current, r)
+	}
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '\\' && i+1 < len(runes):
+			add(r)
+			add(runes[i+1])
+			i++
+		case r == '\'':
+			inQuote = !inQuote
+			add(r)
+		case inQuote:
+			add(r)
+		case r == '#' && depth == 0:
+			for i+1 < len(runes) && runes[i+1] != '\n' {
+				i++
+			}
+		case r == '[':
+			depth++
+			add(r)
+		case r == ']':
+			depth--
+			if depth < 0 {
+				return nil, &RuleSyntaxError{Line: line, Message: "unexpected \"]\""}
+			}
+			add(r)
+		case r == ';' && depth == 0:
+			if len(current) > 0 {
+				res = append(res, ruleStatement{current, start})
+			}
+			current = nil
+		default:
+			add(r)
+		}
+		if runes[i] == '\n' {
+			line++
+		}
+	}
+	if inQuote {
+		return nil, &RuleSyntaxError{Line: line, Message: "unterminated quote"}
+	}
+	if depth > 0 {
+		return nil, &RuleSyntaxError{Line: line, Message: "unterminated set"}
+	}
+	if len(strings.TrimSpace(string(current))) > 0 {
+		res = append(res, ruleStatement{current, start})
+	}
+	return res, nil
+}
+
+var variableDefinitionRegex = regexp.MustCompile(`^\$([\pL_][\pL\pN_]*)\s*=`)
+
+// ruleCompiler compiles the statements into a RuleTransliterator.
+type ruleCompiler struct {
+	resolver  TransformResolver
+	variables map[string][]ruleElem
+	result    *RuleTransliterator
+	rules     []conversionRule
+}
+
+func (compiler *ruleCompiler) flushRules() {
+	if len(compiler.rules) > 0 {
+		compiler.result.phases = append(compiler.result.phases, rulePhase{rules: compiler.rules})
+		compiler.rules = nil
+	}
+}
+
+func (compiler *ruleCompiler) statement(statement ruleStatement) error {
+	scanner := &ruleScanner{runes: statement.text, line: statement.line, variables: compiler.variables}
+	text := string(statement.text)
+	if strings.HasPrefix(text, "::") {
+		scanner.pos = 2
+		return compiler.directive(scanner)
+	}
+	if match := variableDefinitionRegex.FindStringIndex(text); match != nil {
+		name := variableDefinitionRegex.FindStringSubmatch(text)[1]
+		scanner.pos = len([]rune(text[:match[1]]))
+		tokens, err := scanner.tokens()
+		if err != nil {
+			return err
+		}
+		value := make([]ruleElem, 0, len(tokens))
+		for _, token := range tokens {
+			if token.op != "" {
+				return scanner.errorf("unexpected \"%s\" in definition of $%s", token.op, name)
+			}
+			value = append(value, token.elem)
+		}
+		compiler.variables[name] = value
+		return nil
+	}
+	tokens, err := scanner.tokens()
+	if err != nil {
+		return err
+	}
+	return compiler.conversion(scanner, tokens)
+}
+
+func (compiler *ruleCompiler) directive(scanner *ruleScanner) error {
+	scanner.skipSpace()
+	// reverse only directives like "::(NFD) ;" are ignored
+	if scanner.hasPrefix("(") {
+		return nil
+	}
+	var filter runeSet
+	if scanner.atSet() {
+		var err error
+		if filter, err = scanner.readSet(); err != nil {
+			return err
+		}
+	}
+	id := string(scanner.runes[scanner.pos:])
+	if index := strings.IndexRune(id, '('); index >= 0 {
+		id = id[:index]
+	}
+	id = strings.TrimSpace(id)
+	if id == "" {
+		if filter == nil {
+			return nil
+		}
+		if len(compiler.result.phases) > 0 || len(compiler.rules) > 0 || compiler.result.filter != nil {
+			return scanner.errorf("a global filter must be the first statement")
+		}
+		compiler.result.filter = filter
+		return nil
+	}
+	compiler.flushRules()
+	transform, ok := builtinTransform(id)
+	if !ok {
+		if compiler.resolver == nil {
+			return scanner.errorf("unknown transform \"%s\"", id)
+		}
+		var err error
+		if transform, err = compiler.resolver(id); err != nil {
+			return err
+		}
+	}
+	compiler.result.phases = append(compiler.result.phases, rulePhase{filter: filter, transform: transform})
+	return nil
+}
+
+func (compiler *ruleCompiler) conversion(scanner *ruleScanner, tokens []ruleToken) error {
+	operator := -1
+	for i, token := range tokens {
+		if token.op == ">" || token.op == "<" || token.op == "<>" {
+			operator = i
+			break
+		}
+	}
+	if operator < 0 {
+		return scanner.errorf("missing operator")
+	}
+	// rules for the reverse direction are ignored
+	if tokens[operator].op == "<" {
+		return nil
+	}
+	var rule conversionRule
+	left := tokens[:operator]
+	if len(left) > 0 && left[0].op == "^" {
+		rule.anchorStart = true
+		left = left[1:]
+	}
+	if len(left) > 0 && left[len(left)-1].op == "$" {
+		rule.anchorEnd = true
+		left = left[:len(left)-1]
+	}
+	// part 0 is before, 1 is the key and 2 is after
+	var parts [3][]ruleElem
+	part := 0
+	hasOpen := false
+	for _, token := range left {
+		switch token.op {
+		case "":
+			parts[part] = append(parts[part], token.elem)
+		case "{":
+			if part != 0 {
+				return scanner.errorf("unexpected \"{\"")
+			}
+			hasOpen = true
+			part = 1
+		case "}":
+			if part == 2 {
+				return scanner.errorf("unexpected \"}\"")
+			}
+			if !hasOpen {
+				parts[1], parts[0] = parts[0], nil
+			}
+			part = 2
+		default:
+			return scanner.errorf("unexpected \"%s\"", token.op)
+		}
+	}
+	if part == 0 {
+		parts[1], parts[0] = parts[0], nil
+	}
+	rule.before, rule.key, rule.after = parts[0], parts[1], parts[2]
+	if len(rule.key) == 0 {
+		return scanner.errorf("empty source")
+	}
+	rule.cursor = -1
+	for _, token := range tokens[operator+1:] {
+		switch token.op {
+		case "":
+			if token.elem.set != nil {
+				return scanner.errorf("character classes are not allowed in the target")
+			}
+			rule.output = append(rule.output, token.elem.r)
+		case "|":
+			if rule.cursor >= 0 {
+				return scanner.errorf("more than one cursor in the target")
+			}
+			rule.cursor = len(rule.output)
+		case "{", "}":
+			// context in the target is only used in the reverse direction
+		default:
+			return scanner.errorf("unexpected \"%s\"", token.op)
+		}
+	}
+	if rule.cursor < 0 {
+		rule.cursor = len(rule.output)
+	}
+	compiler.rules = append(compiler.rules, rule)
+	return nil
+}
+
+// ParseTransformRules parses transform rules in (a subset of) the ICU / CLDR transform rule syntax and
+// returns a RuleTransliterator that applies them.
+// The rules can be used to support new scripts or change the romanization of a language without writing Go code,
+// for example with AddLanguageTransliterator(language, ToStringHandleFunc(transliterator)).
+//
+// The following syntax is supported:
+// Each statement is terminated by ";", comments start with "#".
+// Conversion rules have the form "before { source } after > target", the context (before and after) is
+// optional. "→" can be used instead of ">", the target can contain a cursor "|" that defines where the
+// transliteration continues (by default after the target). "^" at the beginning and "$" at the end
+// anchor a rule to the beginning / end of the text.
+// Rules for the reverse direction ("<", "←") are ignored, bidirectional rules ("<>", "↔") are used in
+// forward direction.
+// Variables are defined with "$name = value ;" and used with "$name".
+// Literal text can be quoted ("'&'") or escaped ("\&", "ä", "\x{1F600}").
+// Character classes support lists and ranges ("[a-z]"), negation ("[^a-z]"), properties
+// ("[:Latin:]", "[:^Lu:]", "\p{Nonspacing Mark}", "[:Script=Greek:]"), union, intersection ("&") and
+// difference ("-") of nested classes ("[[:Latin:]-[a-z]]").
+// Directives like "::NFD ;" apply a transform to the whole text, a directive can have a filter
+// ("::[:Mn:] Remove ;"). A filter without transform as the first statement ("::[:Greek:] ;") is a
+// global filter, only runes in the filter are transliterated.
+// The transforms "NFD", "NFC", "NFKD", "NFKC", "Lower", "Upper", "Null" and "Remove" are built in, all
+// other transforms are looked up with resolver (which may be nil).
+//
+// As in ICU the rules are applied in the given order, the first matching rule wins. The rules between
+// two directives are applied in one pass.
+//
+// Quantifiers ("*", "+", "?"), segments ("(" and ")") and cursor offsets ("@") are not supported.
+func ParseTransformRules(rules string, resolver TransformResolver) (*RuleTransliterator, error) {
+	statements, err := splitRuleStatements(rules)
+	if err != nil {
+		return nil, err
+	}
+	compiler := &ruleCompiler{
+		resolver:  resolver,
+		variables: make(map[string][]ruleElem),
+		result:    &RuleTransliterator{},
+	}
+	for _, statement := range statements {
+		if err := compiler.statement(statement); err != nil {
+			return nil, err
+		}
+	}
+	compiler.flushRules()
+	return compiler.result, nil
+}
+
+// cldrTransformFile is the XML format of the transform files of CLDR (common/transforms).
+type cldrTransformFile struct {
+	Transforms []struct {
+		Rules []string `xml:"tRule"`
+	} `xml:"transforms>transform"`
+}
+
+// LoadTransformRules reads transform rules from r and parses them with ParseTransformRules.
+// The content can be either plain rules or a CLDR transform file (XML), in this case the rules of all
+// "tRule" elements are used.
+func LoadTransformRules(r io.Reader, resolver TransformResolver) (*RuleTransliterator, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	rules := string(content)
+	if bytes.HasPrefix(bytes.TrimSpace(content), []byte("<")) {
+		var file cldrTransformFile
+		if err := xml.Unmarshal(content, &file); err != nil {
+			return nil, err
+		}
+		var builder strings.Builder
+		for _, transform := range file.Transforms {
+			for _, rule := range transform.Rules {
+				builder.WriteString(rule)
+				builder.WriteRune('\n')
+			}
+		}
+		rules = builder.String()
+	}
+	return ParseTransformRules(rules, resolver)
+}
+
+// fsTransformResolver loads transforms from a file system, loaded transforms are cached.
+type fsTransformResolver struct {
+	fsys    fs.FS
+	dir     string
+	loaded  map[string]StringModifierFunc
+	loading map[string]bool
+}
+
+// transformFileNames returns the file names that are tried for a transform ID.
+func transformFileNames(id string) []string {
+	base := strings.ReplaceAll(id, "/", "-")
+	var res []string
+	for _, name := range []string{base, strings.ReplaceAll(base, "-", "_")} {
+		res = append(res, name+".xml", name+".txt", name)
+	}
+	return res
+}
+
+func (resolver *fsTransformResolver) load(name string) (*RuleTransliterator, error) {
+	file, err := resolver.fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	transliterator, err := LoadTransformRules(file, resolver.resolve)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return transliterator, nil
+}
+
+func (resolver *fsTransformResolver) resolve(id string) (StringModifierFunc, error) {
+	if transform, has := resolver.loaded[id]; has {
+		return transform, nil
+	}
+	if resolver.loading[id] {
+		return nil, fmt.Errorf("transform \"%s\" is used recursively", id)
+	}
+	for _, name := range transformFileNames(id) {
+		name = path.Join(resolver.dir, name)
+		if _, err := fs.Stat(resolver.fsys, name); err != nil {
+			continue
+		}
+		resolver.loading[id] = true
+		transliterator, err := resolver.load(name)
+		delete(resolver.loading, id)
+		if err != nil {
+			return nil, err
+		}
+		transform := ToStringHandleFunc(transliterator)
+		resolver.loaded[id] = transform
+		return transform, nil
+	}
+	return nil, fmt.Errorf("transform \"%s\" not found", id)
+}
+
+func newFSTransformResolver(fsys fs.FS, dir string) *fsTransformResolver {
+	return &fsTransformResolver{
+		fsys:    fsys,
+		dir:     dir,
+		loaded:  make(map[string]StringModifierFunc),
+		loading: make(map[string]bool),
+	}
+}
+
+// NewFSTransformResolver returns a TransformResolver that loads the transforms from the files in dir of
+// fsys, this can for example be an embed.FS containing CLDR transform files.
+// For the ID "Latin-ASCII" the files "Latin-ASCII.xml", "Latin-ASCII.txt", "Latin-ASCII" and the same
+// names with "_" instead of "-" are tried.
+// The files are loaded with LoadTransformRules, transforms used in these files are resolved in the same way.
+func NewFSTransformResolver(fsys fs.FS, dir string) TransformResolver {
+	return newFSTransformResolver(fsys, dir).resolve
+}
+
+// LoadTransformRulesFS loads the transform rules from the file name in fsys (see LoadTransformRules).
+// Transforms used in directives are loaded from the same directory, see NewFSTransformResolver.
+func LoadTransformRulesFS(fsys fs.FS, name string) (*RuleTransliterator, error) {
+	return newFSTransformResolver(fsys, path.Dir(name)).load(name)
+}
+
+// LoadTransformRulesFile loads the transform rules from a file (see LoadTransformRules).
+// Transforms used in directives are loaded from the same directory, see NewFSTransformResolver.
+func LoadTransformRulesFile(name string) (*RuleTransliterator, error) {
+	dir, base := filepath.Split(name)
+	if dir == "" {
+		dir = "."
+	}
+	return LoadTransformRulesFS(os.DirFS(dir), base)
+}
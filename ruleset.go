@@ -0,0 +1,169 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goslugify
+
+import (
+	"strings"
+	"unicode"
+)
+
+// runeSet is a character class of the transform rule syntax, for example "[a-z]" or "[:Latin:]".
+type runeSet func(r rune) bool
+
+func anyRune(r rune) bool {
+	return true
+}
+
+// ruleCategoryAliases contains the long names of the general categories.
+var ruleCategoryAliases = map[string]string{
+	"letter": "L", "casedletter": "LC", "uppercaseletter": "Lu", "lowercaseletter": "Ll", "titlecaseletter": "Lt",
+	"modifierletter": "Lm", "otherletter": "Lo",
+	"mark": "M", "combiningmark": "M", "nonspacingmark": "Mn", "spacingmark": "Mc", "enclosingmark": "Me",
+	"number": "N", "decimalnumber": "Nd", "letternumber": "Nl", "othernumber": "No",
+	"punctuation": "P", "connectorpunctuation": "Pc", "dashpunctuation": "Pd", "openpunctuation": "Ps",
+	"closepunctuation": "Pe", "initialpunctuation": "Pi", "finalpunctuation": "Pf", "otherpunctuation": "Po",
+	"symbol": "S", "mathsymbol": "Sm", "currencysymbol": "Sc", "modifiersymbol": "Sk", "othersymbol": "So",
+	"separator": "Z", "spaceseparator": "Zs", "lineseparator": "Zl", "paragraphseparator": "Zp",
+	"other": "C", "control": "Cc", "format": "Cf", "privateuse": "Co", "surrogate": "Cs",
+}
+
+// ruleScriptAliases contains the ISO 15924 codes of common scripts.
+var ruleScriptAliases = map[string]string{
+	"latn": "Latin", "grek": "Greek", "cyrl": "Cyrillic", "armn": "Armenian", "geor": "Georgian",
+	"arab": "Arabic", "hebr": "Hebrew", "deva": "Devanagari", "beng": "Bengali", "taml": "Tamil",
+	"telu": "Telugu", "thai": "Thai", "laoo": "Lao", "khmr": "Khmer", "ethi": "Ethiopic", "hani": "Han",
+	"hira": "Hiragana", "kana": "Katakana", "hang": "Hangul", "zyyy": "Common", "zinh": "Inherited",
+}
+
+func normalizePropertyName(name string) string {
+	name = strings.ToLower(name)
+	return strings.NewReplacer(" ", "", "_", "", "-", "").Replace(name)
+}
+
+func lookupTable(tables map[string]*unicode.RangeTable, name string) *unicode.RangeTable {
+	for key, table := range tables {
+		if normalizePropertyName(key) == name {
+			return table
+		}
+	}
+	return nil
+}
+
+func lookupCategory(name string) *unicode.RangeTable {
+	if alias, has := ruleCategoryAliases[name]; has {
+		return unicode.Categories[alias]
+	}
+	if name == "lc" {
+		return nil
+	}
+	return lookupTable(unicode.Categories, name)
+}
+
+func lookupScript(name string) *unicode.RangeTable {
+	if alias, has := ruleScriptAliases[name]; has {
+		return unicode.Scripts[alias]
+	}
+	return lookupTable(unicode.Scripts, name)
+}
+
+// lookupProperty returns the set described by a property name like "Latin", "Lu", "Nonspacing Mark" or
+// "Script=Greek".
+func lookupProperty(property string) (runeSet, bool) {
+	key, value := "", property
+	if split := strings.SplitN(property, "=", 2); len(split) == 2 {
+		key, value = normalizePropertyName(split[0]), split[1]
+	}
+	value = normalizePropertyName(value)
+	var table *unicode.RangeTable
+	switch key {
+	case "script", "sc":
+		table = lookupScript(value)
+	case "generalcategory", "gc":
+		table = lookupCategory(value)
+	case "":
+		switch value {
+		case "any":
+			return anyRune, true
+		case "ascii":
+			return func(r rune) bool { return r < unicode.MaxASCII+1 }, true
+		case "lc", "casedletter":
+			return func(r rune) bool { return unicode.In(r, unicode.Lu, unicode.Ll, unicode.Lt) }, true
+		}
+		if table = lookupCategory(value); table == nil {
+			table = lookupScript(value)
+		}
+	}
+	if table == nil {
+		return nil, false
+	}
+	return func(r rune) bool { return unicode.Is(table, r) }, true
+}
+
+// setBuilder collects the items of a set, operations (intersection and difference) are applied to all items
+// collected so far.
+type setBuilder struct {
+	runes map[rune]bool
+	sets  []runeSet
+}
+
+func newSetBuilder() *setBuilder {
+	return &setBuilder{runes: make(map[rune]bool)}
+}
+
+func (builder *setBuilder) addRune(r rune) {
+	builder.runes[r] = true
+}
+
+func (builder *setBuilder) addRange(from, to rune) {
+	builder.sets = append(builder.sets, func(r rune) bool {
+		return r >= from && r <= to
+	})
+}
+
+func (builder *setBuilder) addSet(set runeSet) {
+	builder.sets = append(builder.sets, set)
+}
+
+func (builder *setBuilder) build() runeSet {
+	runes, sets := builder.runes, builder.sets
+	return func(r rune) bool {
+		if runes[r] {
+			return true
+		}
+		for _, set := range sets {
+			if set(r) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// apply replaces the content of the builder by the result of the operation ('&' or '-') with set.
+func (builder *setBuilder) apply(op rune, set runeSet) {
+	current := builder.build()
+	builder.runes = make(map[rune]bool)
+	if op == '&' {
+		builder.sets = []runeSet{func(r rune) bool { return current(r) && set(r) }}
+	} else {
+		builder.sets = []runeSet{func(r rune) bool { return current(r) && !set(r) }}
+	}
+}
+
+func negateSet(set runeSet) runeSet {
+	return func(r rune) bool {
+		return !set(r)
+	}
+}
@@ -16,6 +16,7 @@ package goslugify
 
 import (
 	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/unicode/runenames"
 	"strings"
 	"sync"
 	"unicode"
@@ -164,6 +165,42 @@ func (replacer *WordReplacer) Modify(in string) string {
 	return buf.String()
 }
 
+// NewWordReplaceFunc returns a StringModifierFunc that replaces complete words, the case of a word is ignored.
+// In contrast to WordReplacer a word is not defined by a separator: A word is a maximal sequence of letters,
+// marks and apostrophes (as for the initial and final forms of TableTransliterator). So a replacement
+// "jänner" --> "januar" replaces "JÄNNER" in "1. JÄNNER", but not the beginning of "Jännerwetter".
+// The keys of wordMap must be lower case, the values are used as they are.
+func NewWordReplaceFunc(wordMap StringReplaceMap) StringModifierFunc {
+	// the keys are compared in NFC, thus it doesn't matter if the string is composed or not
+	words := make(StringReplaceMap, len(wordMap))
+	for key, value := range wordMap {
+		words[norm.NFC.String(key)] = value
+	}
+	return func(in string) string {
+		var buf strings.Builder
+		for in != "" {
+			start := strings.IndexFunc(in, isWordRune)
+			if start < 0 {
+				buf.WriteString(in)
+				break
+			}
+			buf.WriteString(in[:start])
+			in = in[start:]
+			end := strings.IndexFunc(in, func(r rune) bool { return !isWordRune(r) })
+			if end < 0 {
+				end = len(in)
+			}
+			word := in[:end]
+			if replaceBy, has := words[norm.NFC.String(strings.ToLower(word))]; has {
+				word = replaceBy
+			}
+			buf.WriteString(word)
+			in = in[end:]
+		}
+		return buf.String()
+	}
+}
+
 // IgnoreInvalidUTF8 is a StringModifierFunc that removes all invalid UTF-8 codepoints from the string.
 // It is usually the first modifier called.
 func IgnoreInvalidUTF8(in string) string {
@@ -243,6 +280,85 @@ func TranslateUmlaut(r rune) (bool, string) {
 	}
 }
 
+// latinSpecialLetters contains Latin letters that have no canonical decomposition (so NFD doesn't help)
+// together with their ASCII replacement.
+var latinSpecialLetters = map[rune]string{
+	'Æ': "Ae", 'æ': "ae",
+	'Ð': "D", 'ð': "d",
+	'Ø': "O", 'ø': "o",
+	'Þ': "Th", 'þ': "th",
+	'Đ': "D", 'đ': "d",
+	'Ħ': "H", 'ħ': "h",
+	'ı': "i",
+	'Ĳ': "IJ", 'ĳ': "ij",
+	'ĸ': "q",
+	'Ŀ': "L", 'ŀ': "l",
+	'Ł': "L", 'ł': "l",
+	'Ŋ': "Ng", 'ŋ': "ng",
+	'Œ': "Oe", 'œ': "oe",
+	'Ŧ': "T", 'ŧ': "t",
+	'ſ': "s",
+	'ƀ': "b", 'Ɓ': "B", 'ɓ': "b",
+	'Ƈ': "C", 'ƈ': "c",
+	'Ɖ': "D", 'ɖ': "d", 'Ɗ': "D", 'ɗ': "d",
+	'Ƒ': "F", 'ƒ': "f",
+	'Ɠ': "G", 'ɠ': "g", 'Ǥ': "G", 'ǥ': "g",
+	'Ɨ': "I", 'ɨ': "i",
+	'Ƙ': "K", 'ƙ': "k",
+	'ƚ': "l", 'Ƚ': "L",
+	'Ɲ': "N", 'ɲ': "n", 'ƞ': "n", 'Ƞ': "N",
+	'Ɵ': "O", 'ɵ': "o",
+	'Ƥ': "P", 'ƥ': "p",
+	'Ƭ': "T", 'ƭ': "t", 'Ʈ': "T", 'ʈ': "t",
+	'Ʉ': "U", 'ʉ': "u",
+	'Ʋ': "V", 'ʋ': "v",
+	'Ƴ': "Y", 'ƴ': "y",
+	'Ƶ': "Z", 'ƶ': "z", 'Ȥ': "Z", 'ȥ': "z",
+	'ȿ': "s", 'ɀ': "z",
+	'Ɍ': "R", 'ɍ': "r",
+	'ʂ': "s", 'ʐ': "z",
+}
+
+// TranslateDiacritics translates Latin letters with diacritics to their ASCII base letters,
+// for example 'é' --> "e", 'å' --> "a" or 'ł' --> "l".
+//
+// For this the rune is decomposed (NFD), all non-spacing marks (category Mn) are removed and
+// letters that have no decomposition (like 'ø', 'đ', 'þ' or 'æ') are mapped to an ASCII
+// replacement.
+// If the result is not pure ASCII the rune is not handled.
+//
+// Note that this function doesn't know anything about language specific rules, for example the
+// German 'ö' would be translated to "o", that's why TranslateUmlaut should be called first.
+func TranslateDiacritics(r rune) (bool, string) {
+	if r < utf8.RuneSelf {
+		return false, ""
+	}
+	if res, has := latinSpecialLetters[r]; has {
+		return true, res
+	}
+	if !unicode.Is(unicode.Latin, r) {
+		return false, ""
+	}
+	var buf strings.Builder
+	for _, decomposed := range norm.NFD.String(string(r)) {
+		if unicode.Is(unicode.Mn, decomposed) {
+			continue
+		}
+		if res, has := latinSpecialLetters[decomposed]; has {
+			buf.WriteString(res)
+			continue
+		}
+		if decomposed >= utf8.RuneSelf {
+			return false, ""
+		}
+		buf.WriteRune(decomposed)
+	}
+	if buf.Len() == 0 {
+		return false, ""
+	}
+	return true, buf.String()
+}
+
 // NewRuneHandleFuncFromMap performs a replace of a single rune given a pre-defined set of
 // replacements.
 // This function will return (true, m[r]) for all entries in m.
@@ -315,6 +431,19 @@ func ValidSlugRuneReplaceFunc(r rune) (bool, string) {
 	return false, ""
 }
 
+func isValidUnicodeSlugRune(r rune) bool {
+	return unicode.In(r, unicode.L, unicode.M, unicode.Nd) || r == '-' || r == '_'
+}
+
+// ValidUnicodeSlugRuneReplaceFunc accepts all runes that are allowed in Unicode slugs (see
+// SlugConfig.AllowUnicode): letters, marks and decimal digits of all scripts, - and _.
+func ValidUnicodeSlugRuneReplaceFunc(r rune) (bool, string) {
+	if isValidUnicodeSlugRune(r) {
+		return true, string(r)
+	}
+	return false, ""
+}
+
 // ReplaceDashAndHyphens replaces any symbol that is considered a hyphen or a dash
 // (according to unicode.Hyphen, unicode.Dash) and replaces it with the rune '-'.
 func ReplaceDashAndHyphens(r rune) (bool, string) {
@@ -324,6 +453,33 @@ func ReplaceDashAndHyphens(r rune) (bool, string) {
 	return false, ""
 }
 
+// NewUnicodeNameFunc returns a RuneHandleFunc that replaces symbols by their name from the Unicode character
+// database, for example "☃" --> "snowman" and "♫" --> "beamed-eighth-notes" (if replaceBy is "-").
+// The name is lower case, the words of the name are separated by replaceBy and the name is surrounded by
+// replaceBy, so it is separated from other words.
+//
+// Only non-ASCII runes from the categories Sm (math symbols), Sc (currency symbols) and So (other symbols)
+// are handled, this function is meant as a fallback for symbols that would otherwise be dropped.
+// Thus it should be used right before ValidSlugRuneReplaceFunc.
+// The runes used in emoji sequences (like regional indicators) are not handled, their names don't make
+// sense on their own.
+func NewUnicodeNameFunc(replaceBy string) RuneHandleFunc {
+	return func(r rune) (bool, string) {
+		if r < utf8.RuneSelf || !unicode.In(r, unicode.Sm, unicode.Sc, unicode.So) {
+			return false, ""
+		}
+		if isEmojiComponent(r) {
+			return false, ""
+		}
+		name := runenames.Name(r)
+		if name == "" {
+			return false, ""
+		}
+		words := strings.Fields(strings.ToLower(name))
+		return true, replaceBy + strings.Join(words, replaceBy) + replaceBy
+	}
+}
+
 // NewReplaceMultiOccurrencesFunc returns a StringModifierFunc that will remove multiple occurrences
 // of the same rune.
 // For example if the separator is '-' you usually want exactly one '-' to separate word.
@@ -406,41 +562,67 @@ func NewTrimFunc(cutset string) StringModifierFunc {
 	}
 }
 
-func getDefaultPreProcessorsWithForm(form norm.Form, toLower bool) []StringModifierFunc {
+// getDefaultPreProcessorsWithForm returns the default pre processors, the string is converted to lower case
+// (or case folded if foldCase is true) according to the rules of the languages.
+func getDefaultPreProcessorsWithForm(form norm.Form, toLower, foldCase bool,
+	languages ...string) []StringModifierFunc {
 	res := []StringModifierFunc{
 		IgnoreInvalidUTF8,
+		RemoveInvisibles,
 	}
+	var normalizer StringModifierFunc
 	switch form {
 	case norm.NFC, norm.NFD, norm.NFKC, norm.NFKD:
-		res = append(res, ToStringHandleFunc(NewUTF8Normalizer(form)))
+		normalizer = ToStringHandleFunc(NewUTF8Normalizer(form))
+		res = append(res, normalizer)
 	}
-	if toLower {
-		res = append(res, strings.ToLower)
+	switch {
+	case toLower && foldCase:
+		res = append(res, NewCaseFoldFunc(languages...))
+		// case folding doesn't preserve the normal form
+		if normalizer != nil {
+			res = append(res, normalizer)
+		}
+	case toLower:
+		res = append(res, NewLowerCaseFunc(languages...))
 	}
 
 	return res
 }
 
 // GetDefaultPreProcessors returns the default list of pre processors, see SlugGenerator for details.
-// The result will contain: IgnoreInvalidUTF8, normalization to NKFC, transforming the string
-// to lowercase codepoints.
+// The result will contain: IgnoreInvalidUTF8, RemoveInvisibles, normalization to NKFC, transforming the string
+// to lowercase codepoints (see NewLowerCaseFunc).
 //
 // Note: There is no guarantee that these processor will always remain the same, it's probable that new ones
 // might be added, even in the same major version (which shouldn't be a problem for most applications).
 func GetDefaultPreProcessors() []StringModifierFunc {
-	return getDefaultPreProcessorsWithForm(norm.NFKC, true)
+	return getDefaultPreProcessorsWithForm(norm.NFKC, true, false)
 }
 
-func getDefaultProcessorsWithConfig(replaceBy string, firstActions ...StringModifierFunc) []StringModifierFunc {
+// getDefaultProcessorsWithConfig returns the default processors, the fallbacks are applied right before
+// ValidSlugRuneReplaceFunc.
+// If allowUnicode is true umlauts and diacritics are not translated and ValidUnicodeSlugRuneReplaceFunc is
+// used instead of ValidSlugRuneReplaceFunc.
+func getDefaultProcessorsWithConfig(replaceBy string, allowUnicode bool, fallbacks []RuneHandleFunc,
+	firstActions ...StringModifierFunc) []StringModifierFunc {
 	res := make([]StringModifierFunc, len(firstActions), len(firstActions)+1)
 	copy(res, firstActions)
 
-	defaultFunc := RuneHandleFuncToStringModifierFunc(ChainRuneHandleFuncs(
+	handlers := []RuneHandleFunc{
 		NewSpaceReplacerFunc(replaceBy),
 		ReplaceDashAndHyphens,
-		TranslateUmlaut,
-		ValidSlugRuneReplaceFunc,
-	))
+	}
+	if !allowUnicode {
+		handlers = append(handlers, TranslateUmlaut, TranslateDiacritics)
+	}
+	handlers = append(handlers, fallbacks...)
+	if allowUnicode {
+		handlers = append(handlers, ValidUnicodeSlugRuneReplaceFunc)
+	} else {
+		handlers = append(handlers, ValidSlugRuneReplaceFunc)
+	}
+	defaultFunc := RuneHandleFuncToStringModifierFunc(ChainRuneHandleFuncs(handlers...))
 
 	res = append(res, defaultFunc)
 	return res
@@ -448,13 +630,13 @@ func getDefaultProcessorsWithConfig(replaceBy string, firstActions ...StringModi
 
 // GetDefaultProcessors returns th default list of processors, see SlugGenerator for details.
 // The result will contain: Replace spaces by "-", replace dashes and hyphens by "-",
-// translate umlauts, finally keep only the default set of codepoints and drop all others
-// (see ValidSlugRuneReplaceFunc).
+// translate umlauts, translate other Latin letters with diacritics (see TranslateDiacritics),
+// finally keep only the default set of codepoints and drop all others (see ValidSlugRuneReplaceFunc).
 //
 // Note: There is no guarantee that these processor will always remain the same, it's probable that new ones
 // might be added, even in the same major version (which shouldn't be a problem for most applications).
 func GetDefaultProcessors() []StringModifierFunc {
-	return getDefaultProcessorsWithConfig("-")
+	return getDefaultProcessorsWithConfig("-", false, nil)
 }
 
 func getDefaultFinalizersWithConfig(replaceBy rune, truncateLength int) []StringModifierFunc {
@@ -486,7 +668,8 @@ func GetDefaultFinalizers() []StringModifierFunc {
 // The idea is that the string passes through all three phases, each doing something different:
 //
 // The pre processor prepares the string to be actually processed later.
-// This by default includes: Remove invalid UTF-8 codepoints from the string, normalize the string
+// This by default includes: Remove invalid UTF-8 codepoints and invisible codepoints (like zero width spaces
+// and soft hyphens) from the string, normalize the string
 // to NKFC (see https://blog.golang.org/normalization) and making the string lower case.
 //
 // The string is then prepared to be actually be processed: Replacements can assume that the string is valid
@@ -496,7 +679,8 @@ func GetDefaultFinalizers() []StringModifierFunc {
 // strings etc.
 // By default this processing phase will do the following: Replace all spaces (" ", newline etc.)
 // by "-", replace all dash symbols (for example the UTF-8 ― by "-", they're different codepoints),
-// translate umlauts like 'ä' --> "ae" or "ß" --> "ss", then drop everything that is not a valid slug codepoint.
+// translate umlauts like 'ä' --> "ae" or "ß" --> "ss", translate other letters with diacritics like 'é' --> "e",
+// then drop everything that is not a valid slug codepoint.
 //
 // After that the string is finalized and converted to a "normal form".
 // By default this includes that all occurrences of more than one "-" are replaced by a single
@@ -618,24 +802,95 @@ func (gen *SlugGenerator) WithFinalizer(modifier StringModifierFunc) *SlugGenera
 // the pre processing.
 //
 // ToLower is by default set to true and the whole string is transformed to all lowercase codepoints
-// in th pre processing phase.
+// in th pre processing phase. The language specific rules of Languages are used, for example "İSTANBUL"
+// becomes "istanbul" for Turkish, see NewLowerCaseFunc.
+//
+// FoldCase is by default set to false, if set to true (and ToLower is true) full Unicode case folding is
+// used instead of lower casing, see NewCaseFoldFunc. This is mostly relevant if AllowUnicode is true,
+// for example "Straße" becomes "strasse" and "ς" becomes "σ".
+//
+// Language is the BCP 47 language tag of the input (like "de-CH" or "en-GB"), it selects the replace map
+// (including symbol names), the transliterator and the case rules in one go. Regional variants fall back
+// to their parents, "de-CH" uses the entries of "de-CH" and "de" (see LanguageFallbacks). By default it is
+// empty, it is used before the entries of Languages.
+//
+// Languages is a list of language codes (like "de" or "ru"), for each language the word map (see
+// GetLanguageWords), the replace map (see GetLanguageMap) and the transliterator (see
+// GetLanguageTransliterator) is used.
+// The words are replaced first, the language replace maps are merged after ReplaceMaps and the
+// transliterators are applied right after the replacement.
+//
+// Registry is the LanguageRegistry in which Language and Languages are looked up, if it is nil
+// DefaultLanguageRegistry is used.
+//
+// Emoji defines how emoji are handled, by default they're dropped. EmojiName replaces them by their
+// CLDR short name ("🚀" --> "rocket") and EmojiKeep keeps them in the slug, see NewEmojiModifier.
+// The emoji are replaced right after the replace maps.
+//
+// UnicodeNames is by default set to false, if set to true symbols that would otherwise be dropped are
+// replaced by their Unicode name ("☃" --> "snowman"), see NewUnicodeNameFunc. With EmojiName and EmojiKeep
+// emoji are handled before, so only with EmojiDrop emoji are replaced by their Unicode name.
+//
+// AllowUnicode is by default set to false, if set to true the slug is not restricted to ASCII (like IRIs):
+// Letters, marks and decimal digits of all scripts are kept (see ValidUnicodeSlugRuneReplaceFunc), thus
+// "Привет мир" becomes "привет-мир". In this mode umlauts and diacritics are not translated and the
+// transliterators of Languages (this includes the letter expansions of languages written in the Latin
+// script, "smörgåsbord" stays "smörgåsbord" in Swedish) are not used, the replace maps of Languages
+// (symbols and words like "&" --> "och") are still used.
+// The slug is normalized to Form (NFKC by default) and lower case if ToLower is true, set FoldCase to get
+// case-folded slugs ("Straße" --> "strasse").
+//
+// Confusables defines how words that mix scripts (like "раypal" with the Cyrillic "р" and "а") are handled,
+// this is only relevant if AllowUnicode is true. By default they're allowed, ConfusableFold replaces the
+// confusable runes and ConfusableReject returns an empty slug, see NewMixedScriptFunc.
+// This check takes place at the beginning of the finalizing phase.
+//
+// RemoveStopWords is by default set to false, if set to true the stop words of Language and Languages
+// (English if no language is given) are removed from the slug, thus "The most interesting species of rodents"
+// becomes "most-interesting-species-rodents", see StopWordFilter and GetStopWords. The stop words are
+// converted to the form they have in the slug first (the German "für" becomes "fuer" in ASCII slugs).
+// If KeepFirstStopWord is true the first word is never removed ("the-who") and if KeepStopWordsIfEmpty is true
+// (the default) the stop words are kept if the slug would be empty otherwise.
+// The stop words are removed in the finalizing phase before the slug is truncated.
 type SlugConfig struct {
-	TruncateLength int
-	WordSeparator  rune
-	Form           norm.Form
-	ReplaceMaps    []StringReplaceMap
-	ToLower        bool
+	TruncateLength       int
+	WordSeparator        rune
+	Form                 norm.Form
+	ReplaceMaps          []StringReplaceMap
+	ToLower              bool
+	FoldCase             bool
+	Language             string
+	Languages            []string
+	Registry             *LanguageRegistry
+	Emoji                EmojiMode
+	UnicodeNames         bool
+	AllowUnicode         bool
+	Confusables          ConfusableMode
+	RemoveStopWords      bool
+	KeepFirstStopWord    bool
+	KeepStopWordsIfEmpty bool
 }
 
 // NewSlugConfig returns the default config that is used by the global GenerateSlug function,
 // just change the fields you want to customize and call Configure.
 func NewSlugConfig() *SlugConfig {
 	return &SlugConfig{
-		TruncateLength: -1,
-		WordSeparator:  '-',
-		Form:           norm.NFKC,
-		ReplaceMaps:    nil,
-		ToLower:        true,
+		TruncateLength:       -1,
+		WordSeparator:        '-',
+		Form:                 norm.NFKC,
+		ReplaceMaps:          nil,
+		ToLower:              true,
+		FoldCase:             false,
+		Language:             "",
+		Languages:            nil,
+		Registry:             nil,
+		Emoji:                EmojiDrop,
+		UnicodeNames:         false,
+		AllowUnicode:         false,
+		Confusables:          ConfusableAllow,
+		RemoveStopWords:      false,
+		KeepFirstStopWord:    false,
+		KeepStopWordsIfEmpty: true,
 	}
 }
 
@@ -644,23 +899,99 @@ func (config *SlugConfig) AddReplaceMap(m StringReplaceMap) {
 	config.ReplaceMaps = append(config.ReplaceMaps, m)
 }
 
+// AddLanguage adds languages to the back of the Languages list.
+func (config *SlugConfig) AddLanguage(languages ...string) {
+	config.Languages = append(config.Languages, languages...)
+}
+
+// getLanguages returns Language (if set) followed by Languages.
+func (config *SlugConfig) getLanguages() []string {
+	if config.Language == "" {
+		return config.Languages
+	}
+	return append([]string{config.Language}, config.Languages...)
+}
+
+// getRegistry returns Registry or DefaultLanguageRegistry if Registry is nil.
+func (config *SlugConfig) getRegistry() *LanguageRegistry {
+	if config.Registry == nil {
+		return DefaultLanguageRegistry
+	}
+	return config.Registry
+}
+
+// getStopWordFilter returns the filter for the stop words of the languages, the stop words are converted to
+// slugs with the settings of this config.
+func (config *SlugConfig) getStopWordFilter() *StopWordFilter {
+	languages := config.getLanguages()
+	if len(languages) == 0 {
+		languages = []string{LanguageEnglish}
+	}
+	wordConfig := *config
+	wordConfig.TruncateLength = -1
+	wordConfig.Confusables = ConfusableAllow
+	wordConfig.RemoveStopWords = false
+	separator := string(config.WordSeparator)
+	stopWords := slugStopWords(config.getRegistry().GetStopWords(languages...), wordConfig.Configure(), separator)
+	filter := NewStopWordFilter(stopWords, separator)
+	filter.KeepFirst = config.KeepFirstStopWord
+	filter.KeepIfEmpty = config.KeepStopWordsIfEmpty
+	return filter
+}
+
 // GetPhases returns the modifiers described by this config.
 // You can use this function if you want to add custom modifiers by your own.
 func (config *SlugConfig) GetPhases() (pre, processors, final []StringModifierFunc) {
-	pre = getDefaultPreProcessorsWithForm(config.Form, config.ToLower)
-
-	// first merge all maps into one
-	replaceMap := MergeStringReplaceMaps(config.ReplaceMaps...)
+	languages := config.getLanguages()
+	registry := config.getRegistry()
+	pre = getDefaultPreProcessorsWithForm(config.Form, config.ToLower, config.FoldCase, languages...)
+
+	// first merge all maps into one, the language maps come last
+	replaceMap := MergeStringReplaceMaps(MergeStringReplaceMaps(config.ReplaceMaps...),
+		registry.GetMap(languages...))
+	var firstActions []StringModifierFunc
+	// the word maps come first, they replace only complete words
+	if wordMap := registry.GetWords(languages...); len(wordMap) > 0 {
+		firstActions = append(firstActions, NewWordReplaceFunc(wordMap))
+	}
 	// if there is at least one entry we create a replacer and pass it in getDefaultProcessorsWithConfig
 	// this replacer will substitute all occurrences, not just whole words
 	if len(replaceMap) > 0 {
 		constReplacer := NewConstantReplacerFromMap(replaceMap)
-		processors = getDefaultProcessorsWithConfig(string(config.WordSeparator), ToStringHandleFunc(constReplacer))
-	} else {
-		processors = getDefaultProcessorsWithConfig(string(config.WordSeparator))
+		firstActions = append(firstActions, ToStringHandleFunc(constReplacer))
+	}
+	if config.Emoji == EmojiName {
+		firstActions = append(firstActions, NewEmojiModifier(EmojiName))
+	}
+	// after that transliterate the string (only if the slug should be ASCII)
+	if !config.AllowUnicode {
+		firstActions = append(firstActions, registry.getTransliterators(languages...)...)
+	}
+	var fallbacks []RuneHandleFunc
+	if config.Emoji == EmojiKeep {
+		fallbacks = append(fallbacks, KeepEmoji)
+	}
+	if config.UnicodeNames {
+		fallbacks = append(fallbacks, NewUnicodeNameFunc(string(config.WordSeparator)))
+	}
+	processors = getDefaultProcessorsWithConfig(string(config.WordSeparator), config.AllowUnicode, fallbacks,
+		firstActions...)
+	// dropping runes can change the normal form (combining marks are reordered, conjoining jamo compose),
+	// thus normalize again
+	if config.AllowUnicode {
+		processors = append(processors, ToStringHandleFunc(NewUTF8Normalizer(config.Form)))
 	}
 
-	final = getDefaultFinalizersWithConfig(config.WordSeparator, config.TruncateLength)
+	final = getDefaultFinalizersWithConfig(config.WordSeparator, -1)
+	if config.RemoveStopWords {
+		final = append(final, ToStringHandleFunc(config.getStopWordFilter()))
+	}
+	if config.TruncateLength >= 0 {
+		final = append(final, NewTruncateFunc(config.TruncateLength, string(config.WordSeparator)))
+	}
+	if config.Confusables != ConfusableAllow {
+		final = append([]StringModifierFunc{NewMixedScriptFunc(config.Confusables, config.WordSeparator)}, final...)
+	}
 	return
 }
 
@@ -717,13 +1048,39 @@ func (config *SlugConfig) GetValidator() func(s string) bool {
 			return false
 		}
 
+		// words that mix scripts are not allowed if confusables are folded or rejected
+		if config.Confusables != ConfusableAllow && hasRiskyWord(s, config.WordSeparator) {
+			return false
+		}
+
+		// the string must be case folded (and normalized again, as in the pre processing phase)
+		if config.AllowUnicode && config.ToLower && config.FoldCase {
+			if !isCaseFolded(s, config.Form) {
+				return false
+			}
+		}
+
 		// now test: only valid runes are contained, taking into account if lower is set
 		// no multiple occurrences of - (or whatever the separator is)
 		// note: we already checked that s doesn't start / end with it
 		isLastSep := false
 
 		for _, r := range s {
-			if config.ToLower {
+			if config.Emoji == EmojiKeep {
+				if isEmoji, _ := KeepEmoji(r); isEmoji {
+					isLastSep = false
+					continue
+				}
+			}
+			if config.AllowUnicode {
+				if !isValidUnicodeSlugRune(r) {
+					return false
+				}
+				// make sure it is a lower case rune (case folding is checked for the whole string)
+				if config.ToLower && !config.FoldCase && (unicode.IsUpper(r) || unicode.IsTitle(r)) {
+					return false
+				}
+			} else if config.ToLower {
 				// make sure it is a lower case rune
 				if !isValidSlugRuneLowerCase(r) {
 					return false
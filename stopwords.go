@@ -0,0 +1,198 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goslugify
+
+import (
+	"strings"
+	"sync"
+)
+
+// StopWords is a set of words that can be removed from slugs because they don't carry much meaning, like
+// "the", "of" or "and" in English. An entry can also be a phrase, the words of a phrase are separated by
+// a space. All entries must be lower case.
+type StopWords map[string]bool
+
+// NewStopWords returns the set of the given words.
+func NewStopWords(words ...string) StopWords {
+	res := make(StopWords, len(words))
+	for _, word := range words {
+		res[word] = true
+	}
+	return res
+}
+
+// MergeStopWords returns the union of the given sets.
+func MergeStopWords(sets ...StopWords) StopWords {
+	res := make(StopWords)
+	for _, set := range sets {
+		for word := range set {
+			res[word] = true
+		}
+	}
+	return res
+}
+
+// StopWordFilter removes stop words from a string, it implements StringModifier.
+// Like WordReplacer it splits the string into words given the WordSeparator, a word is removed if it
+// (in lower case) is contained in StopWords. Phrases are removed if all of their words appear in a row,
+// the longest phrase wins.
+// So with the separator "-" and the English stop words "the-most-interesting-species-of-rodents"
+// becomes "most-interesting-species-rodents".
+//
+// If KeepFirst is true the first word is never removed, "the-who" stays "the-who".
+// If KeepIfEmpty is true and all words are stop words the string is not changed, thus the slug doesn't
+// become empty.
+//
+// Note that you can change the stop words of an existing filter, but only before Modify is called for the
+// first time.
+type StopWordFilter struct {
+	StopWords     StopWords
+	WordSeparator string
+	KeepFirst     bool
+	KeepIfEmpty   bool
+	maxWords      int
+	once          *sync.Once
+}
+
+// NewStopWordFilter returns a new filter given the stop words and the word separator, KeepFirst is false
+// and KeepIfEmpty is true.
+func NewStopWordFilter(stopWords StopWords, wordSeparator string) *StopWordFilter {
+	var once sync.Once
+	return &StopWordFilter{
+		StopWords:     stopWords,
+		WordSeparator: wordSeparator,
+		KeepFirst:     false,
+		KeepIfEmpty:   true,
+		maxWords:      0,
+		once:          &once,
+	}
+}
+
+func (filter *StopWordFilter) computeMaxWords() {
+	for phrase := range filter.StopWords {
+		if n := len(strings.Fields(phrase)); n > filter.maxWords {
+			filter.maxWords = n
+		}
+	}
+}
+
+// match returns the number of words of the longest stop word (or phrase) starting at words[pos].
+func (filter *StopWordFilter) match(words []string, pos int) int {
+	maxLen := filter.maxWords
+	if rest := len(words) - pos; rest < maxLen {
+		maxLen = rest
+	}
+	for l := maxLen; l > 0; l-- {
+		if filter.StopWords[strings.ToLower(strings.Join(words[pos:pos+l], " "))] {
+			return l
+		}
+	}
+	return 0
+}
+
+// Modify implements the StringModifier interface.
+func (filter *StopWordFilter) Modify(in string) string {
+	filter.once.Do(filter.computeMaxWords)
+	if in == "" {
+		return in
+	}
+	words := strings.Split(in, filter.WordSeparator)
+	res := make([]string, 0, len(words))
+	for i := 0; i < len(words); {
+		if i > 0 || !filter.KeepFirst {
+			if n := filter.match(words, i); n > 0 {
+				i += n
+				continue
+			}
+		}
+		res = append(res, words[i])
+		i++
+	}
+	if len(res) == 0 && filter.KeepIfEmpty {
+		return in
+	}
+	return strings.Join(res, filter.WordSeparator)
+}
+
+// builtinStopWords contains the stop words of the built-in languages (articles, conjunctions, prepositions
+// and other very common words), separated by spaces. They're added to the registry in addBuiltinLanguages.
+//
+// For Chinese and Japanese only words of more than one character are included, the romanization of a
+// single character is too ambiguous. For the same reason Vietnamese contains only phrases: Without
+// diacritics many syllables are the same, the stop word "là" and "lá" (leaf) are both "la" in a slug. The
+// words of a phrase are separated by "_".
+var builtinStopWords = map[string]string{
+	LanguageEnglish: "a an and are as at be but by for from has have in is it its of on or that the this to " +
+		"was were will with",
+	LanguageGerman: "aber als am an auf aus bei das dem den der des die ein eine einem einen einer eines für im " +
+		"in ist mit nach oder sich über um und unter vom von vor wie zu zum zur",
+	LanguageRussian:    "а без в во для до же за и из или к как ко ли на над не но о об от по под при про с со то у что это",
+	LanguageUkrainian:  "а або але без в від для до з за і із й на над не по під при про та у це що як",
+	LanguageBulgarian:  "а без в във да до е за и или как към на над не но от по под при с се със това що",
+	LanguageSerbian:    "а али без в да до за и из или је као код на над не о од по под при са се у што",
+	LanguageMacedonian: "а без во да до е за и или како кон на над не но од по под при се со што",
+	LanguageBelarusian: "а або але без да для з і й на над не па пад пра пры у ў што як",
+	LanguageGreek: "ένα ή αλλά από για δεν είναι η θα και με μια να ο οι σε στα στη στην στις στο στον στους τα " +
+		"την της τις το τον του τους των ως",
+	LanguageChinese:    "但是 因为 所以 而且 或者 如果 虽然 然后 以及 还是 这个 那个 我们 他们",
+	LanguageJapanese:   "これ それ あれ この その あの および または そして しかし ため こと もの など",
+	LanguageKorean:     "및 또는 그리고 그러나 하지만 그래서 또한 그 이 저 것 등 위한 대한",
+	LanguageArabic:     "في من إلى على عن مع و أو ثم هذا هذه ذلك التي الذي أن إن لا ما هو هي كان",
+	LanguagePersian:    "و در به از که با را این آن برای یا تا هم بر است یک",
+	LanguageUrdu:       "اور کے کی کا میں سے کو پر یہ وہ ہے ہیں نے یا بھی",
+	LanguageHebrew:     "של את על עם אל גם או אבל כי זה זו הוא היא לא מן",
+	LanguageHindi:      "और का की के में से को पर यह वह है हैं था या भी तथा एक",
+	LanguageMarathi:    "आणि व या ते ही हा की आहे आहेत मध्ये साठी पण एक",
+	LanguageNepali:     "र को का की मा बाट लाई यो त्यो छ हो पनि वा एक",
+	LanguageBengali:    "এবং ও এর এই সেই একটি থেকে জন্য করে হয় না কিন্তু বা যে",
+	LanguageTamil:      "மற்றும் ஒரு இந்த அந்த என்று இது அது ஆனால் அல்லது உள்ள",
+	LanguageTelugu:     "మరియు ఒక ఈ ఆ లో కి కు అని కానీ లేదా కూడా",
+	LanguageThai:       "และ หรือ ของ ที่ ใน กับ จาก เป็น ไม่ ได้ ให้ ว่า แต่ โดย",
+	LanguageLao:        "ແລະ ຫຼື ຂອງ ທີ່ ໃນ ກັບ ຈາກ ເປັນ ບໍ່ ໄດ້ ໃຫ້ ວ່າ ແຕ່ ໂດຍ",
+	LanguageKhmer:      "និង ឬ របស់ ដែល ក្នុង ជាមួយ ពី ជា មិន បាន ឲ្យ ថា ប៉ុន្តែ ដោយ",
+	LanguageArmenian:   "և եւ ու կամ բայց որ այս այդ այն է են մի համար հետ մեջ",
+	LanguageGeorgian:   "და ან მაგრამ რომ ეს ის არის იყო არ ერთი თუ",
+	LanguageAmharic:    "እና ወይም ግን ይህ ያ ነው ናቸው ውስጥ ላይ ጋር",
+	LanguageVietnamese: "bởi_vì tuy_nhiên vì_vậy vì_thế cho_nên do_đó nhưng_mà mặc_dù ngoài_ra trong_khi",
+	LanguageDanish:     "af at de den der det en er et for fra i med og på som til var",
+	LanguageNorwegian:  "av at de den der det en er et for fra i med og på som til var",
+	LanguageBokmal:     "av at de den der det en er et for fra i med og på som til var",
+	LanguageNynorsk:    "av at dei den der det ein eit er for frå i med og på som til var",
+	LanguageSwedish:    "av att de den det en ett för från i med och på som till är var",
+	LanguageFinnish:    "ja tai mutta että on ovat oli se ne tämä tuo kun jos myös",
+	LanguageDutch:      "aan bij de dat die door een en het in is maar met of op te uit van voor zijn",
+	LanguageTurkish:    "ve veya ile bir bu şu o için de da ki gibi ama daha çok en",
+	LanguageIcelandic:  "og eða en að á í um af til frá með við er var sem",
+	LanguagePolish:     "i oraz lub albo ale a w we na z ze do od o dla po przez jest są to że się",
+	LanguageCzech:      "a i nebo ale v ve na z ze do od o pro po s se je jsou to že k",
+	LanguageSlovak:     "a i alebo ale v vo na z zo do od o pre po s so je sú to že k",
+	LanguageHungarian:  "a az egy és vagy de hogy is nem van volt ez mint meg",
+	LanguageRomanian:   "și sau dar a al ale cu de din în la pe pentru un o este sunt că care",
+}
+
+// slugStopWords converts stop words to the form in which they appear in slugs created by gen, for example
+// the German "für" becomes "fuer" and the Russian "для" becomes "dlya". The words of the result are
+// separated by a space (as in StopWords).
+func slugStopWords(stopWords StopWords, gen *SlugGenerator, wordSeparator string) StopWords {
+	res := make(StopWords, len(stopWords))
+	for word := range stopWords {
+		slug := gen.GenerateSlug(word)
+		if slug == "" {
+			continue
+		}
+		res[strings.ToLower(strings.Join(strings.Split(slug, wordSeparator), " "))] = true
+	}
+	return res
+}
@@ -0,0 +1,118 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goslugify
+
+// SymbolWords contains the (romanized) words of a language for the symbols that are read differently in
+// each language, see NewSymbolReplaceMap.
+type SymbolWords struct {
+	Percent  string
+	PerMille string
+	Plus     string
+	Minus    string
+	Times    string
+	Divided  string
+	Equals   string
+	Degrees  string
+}
+
+// CommonSymbolReplaceDict contains replacers for symbols that are the same in all languages: currency signs
+// ("€" --> "euro", "$" --> "dollar"), "©" --> "c", "®" --> "r", "™" --> "tm", vulgar fractions
+// ("½" --> "1-2") and arrows (replaced by a space).
+// All values are surrounded by spaces.
+//
+// Note that NFKC (the default normal form) decomposes some of these symbols before the replacement:
+// "™" becomes "tm" and "½" becomes "1⁄2" (with the fraction slash "⁄"), the fraction slash is replaced by
+// a space as well.
+var CommonSymbolReplaceDict = map[string]string{
+	"€": " euro ", "$": " dollar ", "£": " pound ", "¥": " yen ", "¢": " cent ", "₩": " won ", "₹": " rupee ",
+	"₽": " ruble ", "₺": " lira ", "₪": " shekel ", "฿": " baht ", "₫": " dong ", "₴": " hryvnia ",
+	"₱": " peso ", "₦": " naira ", "₿": " bitcoin ",
+	"©": " c ", "®": " r ", "™": " tm ", "℠": " sm ",
+	"½": " 1-2 ", "⅓": " 1-3 ", "⅔": " 2-3 ", "¼": " 1-4 ", "¾": " 3-4 ", "⅛": " 1-8 ", "⁄": " ",
+	"←": " ", "→": " ", "↑": " ", "↓": " ", "↔": " ", "⇐": " ", "⇒": " ", "⇔": " ", "➔": " ", "➜": " ",
+}
+
+// NewSymbolReplaceMap returns a replace map for the symbols "%", "‰", "+", "−" (the minus sign, not the
+// hyphen), "±", "×", "÷", "=" and "°" with the given words, merged with CommonSymbolReplaceDict.
+// All values are surrounded by spaces, thus "50%" becomes "50-percent" with the default processors.
+// Empty words are ignored.
+func NewSymbolReplaceMap(words SymbolWords) StringReplaceMap {
+	res := make(StringReplaceMap)
+	add := func(symbol, word string) {
+		if word != "" {
+			res[symbol] = " " + word + " "
+		}
+	}
+	add("%", words.Percent)
+	add("‰", words.PerMille)
+	add("+", words.Plus)
+	add("−", words.Minus)
+	if words.Plus != "" && words.Minus != "" {
+		add("±", words.Plus+" "+words.Minus)
+	}
+	add("×", words.Times)
+	add("÷", words.Divided)
+	add("=", words.Equals)
+	add("°", words.Degrees)
+	return MergeStringReplaceMaps(res, CommonSymbolReplaceDict)
+}
+
+// languageSymbolWords contains the symbol words of the built-in languages, they're merged into the language
+// maps in init.
+var languageSymbolWords = map[string]SymbolWords{
+	LanguageEnglish:    {"percent", "per mille", "plus", "minus", "times", "divided by", "equals", "degrees"},
+	LanguageGerman:     {"prozent", "promille", "plus", "minus", "mal", "geteilt durch", "gleich", "grad"},
+	LanguageRussian:    {"protsent", "promille", "plyus", "minus", "umnozhit na", "razdelit na", "ravno", "gradus"},
+	LanguageUkrainian:  {"vidsotok", "promile", "plyus", "minus", "pomnozhyty na", "podilyty na", "dorivnyuye", "hradus"},
+	LanguageBulgarian:  {"protsent", "promil", "plyus", "minus", "po", "razdeleno na", "ravno", "gradus"},
+	LanguageSerbian:    {"posto", "promil", "plus", "minus", "puta", "podeljeno sa", "jednako", "stepen"},
+	LanguageMacedonian: {"procent", "promil", "plus", "minus", "pati", "podeleno so", "ednakvo", "stepen"},
+	LanguageBelarusian: {"pratsent", "pramile", "plyus", "minus", "pamnozhyts na", "padzyalits na", "rouna", "hradus"},
+	LanguageGreek:      {"tois ekato", "tois chiliois", "syn", "meion", "epi", "dia", "ison", "vathmoi"},
+	LanguageChinese:    {"bai fen zhi", "qian fen zhi", "jia", "jian", "cheng", "chu", "deng yu", "du"},
+	LanguageJapanese:   {"paasento", "paamiru", "purasu", "mainasu", "kakeru", "waru", "ikooru", "do"},
+	LanguageKorean:     {"peosenteu", "peomil", "deohagi", "ppaegi", "gopagi", "nanugi", "ikwol", "do"},
+	LanguageArabic:     {"fi almia", "fi alalf", "zaid", "naqis", "darb", "qisma", "yusawi", "daraja"},
+	LanguagePersian:    {"darsad", "dar hezar", "be alave", "menha", "zarb dar", "taqsim bar", "mosavi", "daraje"},
+	LanguageUrdu:       {"fisad", "fi hazar", "jama", "manfi", "zarb", "taqsim", "barabar", "darja"},
+	LanguageHebrew:     {"ahuz", "promil", "plus", "minus", "kaful", "helkei", "shave", "maalot"},
+	LanguageHindi:      {"pratishat", "prati hazar", "jod", "ghata", "guna", "bhag", "barabar", "digri"},
+	LanguageMarathi:    {"takke", "dar hajari", "adhik", "vaja", "guni", "bhagile", "barobar", "ansh"},
+	LanguageNepali:     {"pratishat", "prati hajar", "jod", "ghatau", "guna", "bhag", "barabar", "digri"},
+	LanguageBengali:    {"shatangsha", "prati hajar", "jog", "biyog", "gun", "bhag", "saman", "digri"},
+	LanguageTamil:      {"sathavitham", "ayirathil", "kuttal", "kazhithal", "perukkal", "vakuthal", "samam", "pagai"},
+	LanguageTelugu:     {"shatam", "veyyiki", "kudika", "tisivetha", "guninchu", "bhagaham", "samanam", "digrilu"},
+	LanguageThai:       {"poesen", "to phan", "buak", "lop", "khun", "han", "thaokap", "ongsa"},
+	LanguageLao:        {"poesen", "to phan", "buak", "lop", "khun", "han", "thaokap", "ongsa"},
+	LanguageKhmer:      {"pheakray", "pheak poan", "bouk", "dak", "kun", "chaek", "smae", "angsa"},
+	LanguageArmenian:   {"tokos", "promil", "gumarats", "hanats", "bazmapatkats", "bazhanats", "havasar", "astichan"},
+	LanguageGeorgian:   {"protsenti", "promile", "plus", "minus", "gamravlebuli", "gakopili", "udris", "gradusi"},
+	LanguageAmharic:    {"bemeto", "beshi", "dimir", "kenes", "bezat", "kefl", "ekul", "dirija"},
+	LanguageVietnamese: {"phan tram", "phan nghin", "cong", "tru", "nhan", "chia", "bang", "do"},
+	LanguageDanish:     {"procent", "promille", "plus", "minus", "gange", "divideret med", "lig med", "grader"},
+	LanguageNorwegian:  {"prosent", "promille", "pluss", "minus", "ganger", "delt pa", "er lik", "grader"},
+	LanguageBokmal:     {"prosent", "promille", "pluss", "minus", "ganger", "delt pa", "er lik", "grader"},
+	LanguageNynorsk:    {"prosent", "promille", "pluss", "minus", "gonger", "delt pa", "er lik", "grader"},
+	LanguageSwedish:    {"procent", "promille", "plus", "minus", "ganger", "delat med", "lika med", "grader"},
+	LanguageFinnish:    {"prosenttia", "promillea", "plus", "miinus", "kertaa", "jaettuna", "on yhta kuin", "astetta"},
+	LanguageDutch:      {"procent", "promille", "plus", "min", "keer", "gedeeld door", "is gelijk aan", "graden"},
+	LanguageTurkish:    {"yuzde", "binde", "arti", "eksi", "carpi", "bolu", "esittir", "derece"},
+	LanguageIcelandic:  {"prosent", "prommill", "plus", "minus", "sinnum", "deilt med", "jafnt og", "gradur"},
+	LanguagePolish:     {"procent", "promil", "plus", "minus", "razy", "podzielone przez", "rowna sie", "stopni"},
+	LanguageCzech:      {"procent", "promile", "plus", "minus", "krat", "deleno", "rovna se", "stupnu"},
+	LanguageSlovak:     {"percent", "promile", "plus", "minus", "krat", "delene", "rovna sa", "stupnov"},
+	LanguageHungarian:  {"szazalek", "ezrelek", "plusz", "minusz", "szorozva", "osztva", "egyenlo", "fok"},
+	LanguageRomanian:   {"la suta", "la mie", "plus", "minus", "ori", "impartit la", "egal", "grade"},
+}
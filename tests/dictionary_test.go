@@ -0,0 +1,204 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"errors"
+	"github.com/FabianWe/goslugify"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoadReplaceMap(t *testing.T) {
+	tests := []struct {
+		format  goslugify.DictionaryFormat
+		content string
+	}{
+		{goslugify.DictionaryJSON, `{"&": "and", "@": "at", "ä": ""}`},
+		{goslugify.DictionaryYAML, "\"&\": and\n\"@\": at\nä:\n"},
+		{goslugify.DictionaryCSV, "\ufeff# key,value\n&,and\n\"@\",\"at\"\r\n\nä,\n"},
+	}
+	expected := goslugify.StringReplaceMap{"&": "and", "@": "at", "ä": ""}
+	for _, tc := range tests {
+		m, err := goslugify.LoadReplaceMap(strings.NewReader(tc.content), tc.format)
+		if err != nil {
+			t.Errorf("expected dictionary (format %d) to be loaded, but got error %v", tc.format, err)
+			continue
+		}
+		if len(m) != len(expected) {
+			t.Errorf("expected dictionary (format %d) to be %v, but got %v", tc.format, expected, m)
+			continue
+		}
+		for key, value := range expected {
+			if got, has := m[key]; !has || got != value {
+				t.Errorf("expected \"%s\" to be mapped to \"%s\" (format %d), but got \"%s\"",
+					key, value, tc.format, got)
+			}
+		}
+	}
+}
+
+func TestLoadCSVComments(t *testing.T) {
+	content := "# key,value\n#,comment\n\"#\",hash\n\"#1\",first\n\"a#\",b\n"
+	m, err := goslugify.LoadReplaceMap(strings.NewReader(content), goslugify.DictionaryCSV)
+	if err != nil {
+		t.Fatalf("expected dictionary to be loaded, but got error %v", err)
+	}
+	expected := goslugify.StringReplaceMap{"#": "hash", "#1": "first", "a#": "b"}
+	if len(m) != len(expected) {
+		t.Fatalf("expected dictionary to be %v, but got %v", expected, m)
+	}
+	for key, value := range expected {
+		if got := m[key]; got != value {
+			t.Errorf("expected \"%s\" to be mapped to \"%s\", but got \"%s\"", key, value, got)
+		}
+	}
+}
+
+func TestLoadReplaceMapErrors(t *testing.T) {
+	tests := []struct {
+		format  goslugify.DictionaryFormat
+		content string
+		line    int
+	}{
+		{goslugify.DictionaryJSON, "{\n\"a\": \"b\",\n\"c\": 1\n}", 3},
+		{goslugify.DictionaryJSON, "{\n\"a\": \"b\",\n\"a\": \"c\"\n}", 3},
+		{goslugify.DictionaryJSON, "{\n\"a\": \"b\"\n\"c\": \"d\"\n}", 3},
+		{goslugify.DictionaryJSON, "[\"a\"]", 1},
+		{goslugify.DictionaryYAML, "a: b\nc:\n  d: e\n", 3},
+		{goslugify.DictionaryYAML, "a: b\n\"\": c\n", 2},
+		{goslugify.DictionaryYAML, "a: b\nc: d\n  e: f\n", 3},
+		{goslugify.DictionaryCSV, "a,b\nc,d,e\n", 2},
+		{goslugify.DictionaryCSV, "a,b\n\n\"c,d\n", 3},
+		{goslugify.DictionaryCSV, "# header\na,b\na,c\n", 3},
+	}
+	for _, tc := range tests {
+		_, err := goslugify.LoadReplaceMap(strings.NewReader(tc.content), tc.format)
+		var dictErr *goslugify.DictionaryError
+		if !errors.As(err, &dictErr) {
+			t.Errorf("expected dictionary error for %q (format %d), but got %v", tc.content, tc.format, err)
+			continue
+		}
+		if dictErr.Line != tc.line {
+			t.Errorf("expected dictionary error for %q (format %d) in line %d, but got line %d (%v)",
+				tc.content, tc.format, tc.line, dictErr.Line, err)
+		}
+	}
+}
+
+func TestLoadRuneMap(t *testing.T) {
+	fsys := fstest.MapFS{
+		"letters/sv.yaml":  {Data: []byte("å: a\nä: a\nö: o\n")},
+		"letters/bad.json": {Data: []byte("{\n\"å\": \"a\",\n\"ae\": \"a\"\n}")},
+	}
+	letters, err := goslugify.LoadRuneMapFS(fsys, "letters/sv.yaml")
+	if err != nil {
+		t.Fatalf("expected rune map to be loaded, but got error %v", err)
+	}
+	if len(letters) != 3 || letters['ö'] != "o" {
+		t.Errorf("expected rune map of Swedish letters, but got %v", letters)
+	}
+
+	_, err = goslugify.LoadRuneMapFS(fsys, "letters/bad.json")
+	var dictErr *goslugify.DictionaryError
+	if !errors.As(err, &dictErr) || dictErr.File != "letters/bad.json" || dictErr.Line != 3 {
+		t.Errorf("expected dictionary error in letters/bad.json line 3, but got %v", err)
+	}
+
+	if _, err := goslugify.LoadRuneMapFS(fsys, "letters/sv.txt"); err == nil {
+		t.Error("expected an error for an unknown dictionary format")
+	}
+}
+
+func TestLoadLetters(t *testing.T) {
+	fsys := fstest.MapFS{
+		"letters/fo.csv":  {Data: []byte("# Faroese\nø,oe\ná,aa\n")},
+		"letters/bad.csv": {Data: []byte("ø,oe\nae,a\n")},
+	}
+	registry := goslugify.DefaultLanguageRegistry.Clone()
+	if err := registry.LoadLettersFS(fsys, "fo", "letters/fo.csv"); err != nil {
+		t.Fatalf("expected letters to be loaded, but got error %v", err)
+	}
+	tests := []struct {
+		allowUnicode bool
+		in, expected string
+	}{
+		{false, "Føroyar", "foeroyar"},
+		{false, "Ánavík", "aanavik"},
+		{true, "Føroyar", "føroyar"},
+	}
+	for _, tc := range tests {
+		config := goslugify.NewSlugConfig()
+		config.Registry = registry
+		config.Language = "fo"
+		config.AllowUnicode = tc.allowUnicode
+		if got := config.Configure().GenerateSlug(tc.in); got != tc.expected {
+			t.Errorf("expected slug of \"%s\" (unicode %v) to be \"%s\", but got \"%s\"",
+				tc.in, tc.allowUnicode, tc.expected, got)
+		}
+	}
+	err := registry.LoadLettersFS(fsys, "xx", "letters/bad.csv")
+	var dictErr *goslugify.DictionaryError
+	if !errors.As(err, &dictErr) || dictErr.Line != 2 {
+		t.Errorf("expected dictionary error in line 2, but got %v", err)
+	}
+	for _, language := range registry.Languages() {
+		if language == "xx" {
+			t.Error("expected no transliterator for an invalid dictionary")
+		}
+	}
+}
+
+func TestLoadMapsFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"dicts/de-AT.csv":  {Data: []byte("# key,value\nerdäpfel,kartoffeln\n")},
+		"dicts/en.json":    {Data: []byte(`{"&": "n"}`)},
+		"dicts/README.txt": {Data: []byte("not a dictionary")},
+	}
+	registry := goslugify.DefaultLanguageRegistry.Clone()
+	if err := registry.LoadMapsFS(fsys, "dicts"); err != nil {
+		t.Fatalf("expected dictionaries to be loaded, but got error %v", err)
+	}
+	tests := []struct {
+		language, in, expected string
+	}{
+		{"de-AT", "Erdäpfel & Jänner", "kartoffeln-und-januar"},
+		{"en", "Rock & Roll 100%", "rock-n-roll-100-percent"},
+	}
+	for _, tc := range tests {
+		config := goslugify.NewSlugConfig()
+		config.Registry = registry
+		config.Language = tc.language
+		got := config.Configure().GenerateSlug(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected slug of \"%s\" (language %s) to be \"%s\", but got \"%s\"",
+				tc.in, tc.language, tc.expected, got)
+		}
+	}
+
+	dir := t.TempDir()
+	name := filepath.Join(dir, "fr.yaml")
+	if err := os.WriteFile(name, []byte("\"&\": et\n\"&\": plus\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	err := goslugify.NewLanguageRegistry().LoadMapsDir(dir)
+	var dictErr *goslugify.DictionaryError
+	if !errors.As(err, &dictErr) || dictErr.File != name || dictErr.Line != 2 {
+		t.Errorf("expected dictionary error in %s line 2, but got %v", name, err)
+	}
+}
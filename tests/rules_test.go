@@ -0,0 +1,147 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"errors"
+	"github.com/FabianWe/goslugify"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestParseTransformRules(t *testing.T) {
+	tests := []struct {
+		rules    string
+		in       string
+		expected string
+	}{
+		{"a > b ; b > c ;", "abc", "bcc"},
+		{"ab > x ; a > y ;", "aab", "yx"},
+		{"a } b > x ; a > y ;", "aab", "yxb"},
+		{"[aeiou] { s } [aeiou] > z ;", "rose sun", "roze sun"},
+		{"^ a > x ; a $ > z ;", "aaa", "xaz"},
+		{"$vowel = [aeiou] ; $vowel > V ; # comment", "hello", "hVllV"},
+		{"$ab = ab ; $ab > X ;", "cabab", "cXX"},
+		{"'&' > and ; \\' > '' ; \\u00E4 > ae ; '\\x{1F600}' > smile ;", "&'ä", "and'ae"},
+		{"[a-c] > x ; [^x] > y ;", "abcd", "xxxy"},
+		{"[:Lu:] > U ; [[:L:]-[a]] > l ;", "Aab", "Ual"},
+		{"\\p{Greek} > g ; [:^Latin:] > _ ;", "aβ1", "ag_"},
+		{"a > b | c ; c > d ;", "a", "bd"},
+		{"a <> b ; c ↔ d ; e → f ; x < y ;", "acex", "bdfx"},
+		{"::[:Greek:] ; α > a ; a > b ;", "aα", "aa"},
+		{"::NFD ; ::[:Mn:] Remove ; ::NFC ;", "café", "cafe"},
+		{"::Any-Upper ; A > x ;", "abc", "xBC"},
+		{"::[a] Upper ;", "abca", "AbcA"},
+		{"::NFD (NFC) ; ::(Lower) ; e > i ;", "\u00e9", "i\u0301"},
+		// deleting rules must be applied to inputs longer than the limit for stalls
+		{"x > ;", strings.Repeat("x", 40), ""},
+		{"x > ;", "a" + strings.Repeat("x", 40) + "b", "ab"},
+	}
+	for _, tc := range tests {
+		transliterator, err := goslugify.ParseTransformRules(tc.rules, nil)
+		if err != nil {
+			t.Errorf("expected rules \"%s\" to be valid, but got error %v", tc.rules, err)
+			continue
+		}
+		got := transliterator.Modify(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected \"%s\" with rules \"%s\" to be \"%s\", but got \"%s\"",
+				tc.in, tc.rules, tc.expected, got)
+		}
+	}
+}
+
+func TestParseTransformRulesErrors(t *testing.T) {
+	tests := []struct {
+		rules string
+		line  int
+	}{
+		{"a b ;", 1},
+		{"a > b ;\n\nc* > d ;", 3},
+		{"a > $undefined ;", 1},
+		{"[a-z > b ;", 1},
+		{"'a > b ;", 1},
+		{"> b ;", 1},
+		{"a > [b] ;", 1},
+		{"[:Unknown:] > a ;", 1},
+		{"a > b ;\n::Latin-ASCII ;", 2},
+		{"a > b ;\n::[:Latin:] ;", 2},
+	}
+	for _, tc := range tests {
+		_, err := goslugify.ParseTransformRules(tc.rules, nil)
+		var syntaxErr *goslugify.RuleSyntaxError
+		if !errors.As(err, &syntaxErr) {
+			t.Errorf("expected syntax error for rules \"%s\", but got %v", tc.rules, err)
+			continue
+		}
+		if syntaxErr.Line != tc.line {
+			t.Errorf("expected syntax error for rules \"%s\" in line %d, but got line %d",
+				tc.rules, tc.line, syntaxErr.Line)
+		}
+	}
+}
+
+func TestLoadTransformRules(t *testing.T) {
+	xmlRules := `<?xml version="1.0" encoding="UTF-8" ?>
+<supplementalData>
+	<version number="$Revision$"/>
+	<transforms>
+		<transform source="Latin" target="ASCII" direction="forward">
+			<tRule><![CDATA[
+ö > oe ;
+ß > ss ;
+			]]></tRule>
+		</transform>
+	</transforms>
+</supplementalData>`
+	fsys := fstest.MapFS{
+		"transforms/Latin-ASCII.xml": {Data: []byte(xmlRules)},
+		"transforms/Any-Test.txt":    {Data: []byte("::Latin-ASCII ;\nä > ae ;")},
+		"transforms/Cycle-A.txt":     {Data: []byte("::Cycle-B ;")},
+		"transforms/Cycle-B.txt":     {Data: []byte("::Cycle-A ;")},
+	}
+	transliterator, err := goslugify.LoadTransformRulesFS(fsys, "transforms/Any-Test.txt")
+	if err != nil {
+		t.Fatalf("expected rules to be loaded, but got error %v", err)
+	}
+	if got := transliterator.Modify("Größe ä"); got != "Groesse ae" {
+		t.Errorf("expected \"Größe ä\" to be \"Groesse ae\", but got \"%s\"", got)
+	}
+
+	transliterator, err = goslugify.LoadTransformRules(strings.NewReader(xmlRules), nil)
+	if err != nil {
+		t.Fatalf("expected XML rules to be loaded, but got error %v", err)
+	}
+	if got := transliterator.Modify("schön"); got != "schoen" {
+		t.Errorf("expected \"schön\" to be \"schoen\", but got \"%s\"", got)
+	}
+
+	resolver := goslugify.NewFSTransformResolver(fsys, "transforms")
+	transliterator, err = goslugify.ParseTransformRules(":: Latin-ASCII ;", resolver)
+	if err != nil {
+		t.Fatalf("expected transform to be resolved, but got error %v", err)
+	}
+	if got := transliterator.Modify("süß"); got != "süss" {
+		t.Errorf("expected \"süß\" to be \"süss\", but got \"%s\"", got)
+	}
+
+	if _, err := goslugify.LoadTransformRulesFS(fsys, "transforms/Cycle-A.txt"); err == nil {
+		t.Error("expected an error for recursive transforms")
+	}
+	if _, err := goslugify.LoadTransformRulesFS(fsys, "transforms/Missing.txt"); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
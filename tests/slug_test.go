@@ -98,6 +98,54 @@ func TestIgnoreInvalidUTF8(t *testing.T) {
 	}
 }
 
+func TestRemoveInvisibles(t *testing.T) {
+	tests := []struct {
+		in, expected string
+	}{
+		{"foo bar", "foo bar"},
+		{"foo\u200bbar", "foo bar"},
+		{"Silben\u00adtrennung", "Silbentrennung"},
+		{"\ufefffoo\u2060bar", "foobar"},
+		{"\u202epaypal\u202c", "paypal"},
+		{"می\u200cخواهم", "میخواهم"},
+		{"👩\u200d💻", "👩\u200d💻"},
+		{"❤\ufe0f", "❤\ufe0f"},
+		{"foo\u200dbar\ufe0f", "foobar"},
+	}
+	for _, tc := range tests {
+		got := goslugify.RemoveInvisibles(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected that the removement of invisible runes from %q is %q, but got %q",
+				tc.in, tc.expected, got)
+		}
+	}
+}
+
+func TestInvisiblesSlug(t *testing.T) {
+	tests := []struct {
+		in, expected string
+	}{
+		{"foo\u200bbar", "foo-bar"},
+		{"Silben\u00adtrennung", "silbentrennung"},
+		{"\ufeffHello\u200d World", "hello-world"},
+	}
+	for _, tc := range tests {
+		got := goslugify.GenerateSlug(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected slug of %q to be \"%s\", but got \"%s\"", tc.in, tc.expected, got)
+		}
+	}
+	config := goslugify.NewSlugConfig()
+	config.AllowUnicode = true
+	if got := config.Configure().GenerateSlug("при\u200dвет\u00ad мир"); got != "привет-мир" {
+		t.Errorf("expected Unicode slug to be \"привет-мир\", but got \"%s\"", got)
+	}
+	config.Emoji = goslugify.EmojiName
+	if got := config.Configure().GenerateSlug("👩\u200d💻"); got != "woman-technologist" {
+		t.Errorf("expected emoji slug to be \"woman-technologist\", but got \"%s\"", got)
+	}
+}
+
 func TestSpaceReplacerFunc(t *testing.T) {
 	withDash := goslugify.RuneHandleFuncToStringModifierFunc(
 		goslugify.ChainRuneHandleFuncs(goslugify.NewSpaceReplacerFunc("-"),
@@ -392,3 +440,580 @@ func TestTranslateUmlaut(t *testing.T) {
 			in, expected, got)
 	}
 }
+
+func TestTranslateDiacritics(t *testing.T) {
+	modifier := goslugify.RuneHandleFuncToStringModifierFunc(
+		goslugify.ChainRuneHandleFuncs(goslugify.TranslateDiacritics, goslugify.KeepAllFunc))
+	tests := []struct {
+		in, expected string
+	}{
+		{"abc", "abc"},
+		{"café crème", "cafe creme"},
+		{"Ångström", "Angstrom"},
+		{"łódź", "lodz"},
+		{"Øresund", "Oresund"},
+		{"đak", "dak"},
+		{"þór", "thor"},
+		{"œuvre", "oeuvre"},
+		{"ħ ı", "h i"},
+		{"世界", "世界"},
+	}
+	for _, tc := range tests {
+		got := modifier(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected TranslateDiacritics(\"%s\") to be \"%s\", but got \"%s\"",
+				tc.in, tc.expected, got)
+		}
+	}
+}
+
+func TestGenerateSlugDiacritics(t *testing.T) {
+	tests := []struct {
+		in, expected string
+	}{
+		{"Café Crème", "cafe-creme"},
+		{"Ångström", "angstroem"},
+		{"Über Straße", "ueber-strasse"},
+		{"Żółć i Łódź", "zolc-i-lodz"},
+		{"Ærøskøbing", "aeroskobing"},
+	}
+	for _, tc := range tests {
+		got := goslugify.GenerateSlug(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected GenerateSlug(\"%s\") to be \"%s\", but got \"%s\"",
+				tc.in, tc.expected, got)
+		}
+	}
+}
+
+func TestEmojiModifier(t *testing.T) {
+	tests := []struct {
+		mode     goslugify.EmojiMode
+		in       string
+		expected string
+	}{
+		{goslugify.EmojiName, "🚀", " rocket "},
+		{goslugify.EmojiName, "🇩🇪", " flag germany "},
+		{goslugify.EmojiName, "I ❤️ Go", "I  red heart  Go"},
+		{goslugify.EmojiName, "👍🏽", " thumbs up medium skin tone "},
+		{goslugify.EmojiName, "👩‍💻", " woman technologist "},
+		{goslugify.EmojiName, "👨‍👩‍👧‍👦", " family man woman girl boy "},
+		{goslugify.EmojiName, "1️⃣", " keycap 1 "},
+		{goslugify.EmojiName, "🇦🇦", " flag aa "},
+		{goslugify.EmojiName, "foo", "foo"},
+		{goslugify.EmojiDrop, "I ❤️ Go 👩🏽‍💻", "I  Go "},
+		{goslugify.EmojiKeep, "I ❤️ Go", "I ❤️ Go"},
+	}
+	for _, tc := range tests {
+		got := goslugify.NewEmojiModifier(tc.mode)(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected emoji modifier (mode %d) of \"%s\" to be \"%s\", but got \"%s\"",
+				tc.mode, tc.in, tc.expected, got)
+		}
+	}
+}
+
+func TestEmojiSlug(t *testing.T) {
+	tests := []struct {
+		mode     goslugify.EmojiMode
+		in       string
+		expected string
+	}{
+		{goslugify.EmojiDrop, "I ❤️ Go 🚀", "i-go"},
+		{goslugify.EmojiName, "I ❤️ Go 🚀", "i-red-heart-go-rocket"},
+		{goslugify.EmojiName, "👩🏽‍💻 coder", "woman-technologist-medium-skin-tone-coder"},
+		{goslugify.EmojiName, "Piñata 🪅", "pinata-pinata"},
+		{goslugify.EmojiName, "🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f", "flag-england"},
+		{goslugify.EmojiKeep, "I ❤️ Go 🚀", "i-❤️-go-🚀"},
+	}
+	for _, tc := range tests {
+		config := goslugify.NewSlugConfig()
+		config.Emoji = tc.mode
+		got := config.Configure().GenerateSlug(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected slug (emoji mode %d) of \"%s\" to be \"%s\", but got \"%s\"",
+				tc.mode, tc.in, tc.expected, got)
+		}
+		if !config.GetValidator()(got) {
+			t.Errorf("expected \"%s\" to be a valid slug (emoji mode %d)", got, tc.mode)
+		}
+	}
+}
+
+func TestNewSymbolReplaceMap(t *testing.T) {
+	m := goslugify.NewSymbolReplaceMap(goslugify.SymbolWords{Percent: "percent", Plus: "plus", Minus: "minus"})
+	tests := []struct {
+		symbol   string
+		expected string
+	}{
+		{"%", " percent "},
+		{"+", " plus "},
+		{"−", " minus "},
+		{"±", " plus minus "},
+		{"€", " euro "},
+		{"½", " 1-2 "},
+		{"=", ""},
+	}
+	for _, tc := range tests {
+		if got := m[tc.symbol]; got != tc.expected {
+			t.Errorf("expected \"%s\" to be mapped to \"%s\", but got \"%s\"", tc.symbol, tc.expected, got)
+		}
+	}
+}
+
+func TestSymbolSlug(t *testing.T) {
+	tests := []struct {
+		language string
+		in       string
+		expected string
+	}{
+		{"", "50% off", "50-off"},
+		{"en", "50% off", "50-percent-off"},
+		{"de", "50% Rabatt", "50-prozent-rabatt"},
+		{"en", "5€ + 3$", "5-euro-plus-3-dollar"},
+		{"de", "20°C", "20-grad-c"},
+		{"en", "2×3=6", "2-times-3-equals-6"},
+		{"en", "½ price", "1-2-price"},
+		{"en", "Foo™ & Bar©", "footm-and-bar-c"},
+		{"en", "Berlin → Paris", "berlin-paris"},
+		{"fr", "50%", "50"},
+		{"tr", "%50 indirim", "yuzde-50-indirim"},
+		{"ru", "100% гарантия", "100-protsent-garantiya"},
+	}
+	for _, tc := range tests {
+		config := goslugify.NewSlugConfig()
+		if tc.language != "" {
+			config.AddLanguage(tc.language)
+		}
+		got := config.Configure().GenerateSlug(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected slug of \"%s\" (language %s) to be \"%s\", but got \"%s\"",
+				tc.in, tc.language, tc.expected, got)
+		}
+	}
+}
+
+func TestUnicodeNameFunc(t *testing.T) {
+	f := goslugify.NewUnicodeNameFunc("-")
+	tests := []struct {
+		in       rune
+		handled  bool
+		expected string
+	}{
+		{'☃', true, "-snowman-"},
+		{'★', true, "-black-star-"},
+		{'♫', true, "-beamed-eighth-notes-"},
+		{'∑', true, "-n-ary-summation-"},
+		{'₪', true, "-new-sheqel-sign-"},
+		{'$', false, ""},
+		{'a', false, ""},
+		{'ж', false, ""},
+		{'!', false, ""},
+		// emoji components are not named
+		{'\U0001F1E9', false, ""},
+		{'\U0001F3FD', false, ""},
+	}
+	for _, tc := range tests {
+		handled, got := f(tc.in)
+		if handled != tc.handled || got != tc.expected {
+			t.Errorf("expected unicode name of '%c' to be (%v, \"%s\"), but got (%v, \"%s\")",
+				tc.in, tc.handled, tc.expected, handled, got)
+		}
+	}
+}
+
+func TestUnicodeNamesSlug(t *testing.T) {
+	config := goslugify.NewSlugConfig()
+	config.UnicodeNames = true
+	generator := config.Configure()
+	validator := config.GetValidator()
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{"☃", "snowman"},
+		{"★", "black-star"},
+		{"I ♥ music ♫", "i-black-heart-suit-music-beamed-eighth-notes"},
+		{"50% off", "50-off"},
+		{"Straße", "strasse"},
+	}
+	for _, tc := range tests {
+		got := generator.GenerateSlug(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected slug of \"%s\" to be \"%s\", but got \"%s\"", tc.in, tc.expected, got)
+		}
+		if !validator(got) {
+			t.Errorf("expected \"%s\" to be a valid slug", got)
+		}
+	}
+}
+
+func TestUnicodeNamesEmoji(t *testing.T) {
+	tests := []struct {
+		emoji        goslugify.EmojiMode
+		in, expected string
+	}{
+		{goslugify.EmojiDrop, "I ♥ music ☃ ★", "i-black-heart-suit-music-snowman-black-star"},
+		{goslugify.EmojiDrop, "🚀 launch", "rocket-launch"},
+		{goslugify.EmojiDrop, "🇩🇪 flag", "flag"},
+		{goslugify.EmojiName, "I ♥ music ☃ ★", "i-heart-suit-music-snowman-black-star"},
+		{goslugify.EmojiName, "🚀 launch", "rocket-launch"},
+		{goslugify.EmojiKeep, "I ☃ snow ★", "i-☃-snow-black-star"},
+	}
+	for _, tc := range tests {
+		config := goslugify.NewSlugConfig()
+		config.UnicodeNames = true
+		config.Emoji = tc.emoji
+		got := config.Configure().GenerateSlug(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected slug of \"%s\" (emoji mode %d) to be \"%s\", but got \"%s\"",
+				tc.in, tc.emoji, tc.expected, got)
+		}
+	}
+}
+
+func TestAllowUnicode(t *testing.T) {
+	config := goslugify.NewSlugConfig()
+	config.AllowUnicode = true
+	generator := config.Configure()
+	validator := config.GetValidator()
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{"Привет, мир!", "привет-мир"},
+		{"日本語のテキスト", "日本語のテキスト"},
+		{"Größe Über", "größe-über"},
+		{"हिन्दी भाषा", "हिन्दी-भाषा"},
+		{"ｆｕｌｌ　ｗｉｄｔｈ", "full-width"},
+		{"café ☕ 42", "café-42"},
+		{"-foo--bar-", "foo-bar"},
+		// dropping a rune changes the normal form, the slug must be normalized again
+		{"a\u0301☆\u0323", "\u1ea1\u0301"},
+		{"\u1100☆\u1161", "가"},
+	}
+	for _, tc := range tests {
+		got := generator.GenerateSlug(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected unicode slug of \"%s\" to be \"%s\", but got \"%s\"", tc.in, tc.expected, got)
+		}
+		if !validator(got) {
+			t.Errorf("expected \"%s\" to be a valid unicode slug", got)
+		}
+	}
+}
+
+func TestAllowUnicodeLanguage(t *testing.T) {
+	tests := []struct {
+		language     string
+		foldCase     bool
+		in, expected string
+	}{
+		{"sv", false, "Smörgåsbord & öl", "smörgåsbord-och-öl"},
+		{"tr", false, "IĞDIR & Şişli", "ığdır-ve-şişli"},
+		{"da", false, "Blåbærgrød", "blåbærgrød"},
+		{"de", true, "STRASSE Straße", "strasse-strasse"},
+	}
+	for _, tc := range tests {
+		config := goslugify.NewSlugConfig()
+		config.Language = tc.language
+		config.AllowUnicode = true
+		config.FoldCase = tc.foldCase
+		got := config.Configure().GenerateSlug(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected unicode slug of \"%s\" (language %s) to be \"%s\", but got \"%s\"",
+				tc.in, tc.language, tc.expected, got)
+		}
+		if !config.GetValidator()(got) {
+			t.Errorf("expected \"%s\" to be a valid unicode slug", got)
+		}
+	}
+	// the letter expansions are still used for ASCII slugs
+	config := goslugify.NewSlugConfig()
+	config.Language = "sv"
+	if got := config.Configure().GenerateSlug("Smörgåsbord & öl"); got != "smorgasbord-och-ol" {
+		t.Errorf("expected slug of \"Smörgåsbord & öl\" (language sv) to be \"smorgasbord-och-ol\", but got \"%s\"",
+			got)
+	}
+}
+
+func TestAllowUnicodeValidator(t *testing.T) {
+	config := goslugify.NewSlugConfig()
+	config.AllowUnicode = true
+	validator := config.GetValidator()
+	tests := []struct {
+		in       string
+		expected bool
+	}{
+		{"привет-мир", true},
+		{"größe", true},
+		{"Größe", false},
+		{"foo bar", false},
+		{"foo!", false},
+		{"cafe\u0301", false},
+		{"-foo", false},
+	}
+	for _, tc := range tests {
+		if got := validator(tc.in); got != tc.expected {
+			t.Errorf("expected unicode validator of \"%s\" to be %v, but got %v", tc.in, tc.expected, got)
+		}
+	}
+	// without AllowUnicode these are not valid
+	if goslugify.IsSlug("привет-мир") {
+		t.Error("expected \"привет-мир\" not to be a valid ASCII slug")
+	}
+}
+
+func TestSkeleton(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected bool
+	}{
+		{"раypal", "paypal", true},
+		{"ρaypal", "paypal", true},
+		{"rn", "m", true},
+		{"l1I", "lll", true},
+		{"g00gle", "gOOgle", true},
+		{"москва", "moskva", false},
+		{"foo", "bar", false},
+	}
+	for _, tc := range tests {
+		if got := goslugify.AreConfusable(tc.a, tc.b); got != tc.expected {
+			t.Errorf("expected confusable of \"%s\" and \"%s\" to be %v, but got %v", tc.a, tc.b, tc.expected, got)
+		}
+	}
+}
+
+func TestGetRestrictionLevel(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected goslugify.RestrictionLevel
+	}{
+		{"paypal", goslugify.ASCIIOnly},
+		{"москва", goslugify.SingleScript},
+		{"größe", goslugify.SingleScript},
+		{"日本語のテキスト", goslugify.HighlyRestrictive},
+		{"iphone-آیفون", goslugify.ModeratelyRestrictive},
+		{"раypal", goslugify.MinimallyRestrictive},
+		{"αβγ-москва", goslugify.MinimallyRestrictive},
+	}
+	for _, tc := range tests {
+		if got := goslugify.GetRestrictionLevel(tc.in); got != tc.expected {
+			t.Errorf("expected restriction level of \"%s\" to be %d, but got %d", tc.in, tc.expected, got)
+		}
+	}
+	if !goslugify.IsMixedScript("раypal") || goslugify.IsMixedScript("paypal-123") {
+		t.Error("expected only \"раypal\" to be mixed script")
+	}
+}
+
+func TestConfusableSlug(t *testing.T) {
+	tests := []struct {
+		mode     goslugify.ConfusableMode
+		in       string
+		expected string
+	}{
+		{goslugify.ConfusableAllow, "раypal", "раypal"},
+		{goslugify.ConfusableFold, "раypal", "paypal"},
+		{goslugify.ConfusableFold, "мoсква", "москва"},
+		{goslugify.ConfusableFold, "москва moscow", "москва-moscow"},
+		{goslugify.ConfusableReject, "раypal login", ""},
+		{goslugify.ConfusableReject, "日本語のテキスト", "日本語のテキスト"},
+	}
+	for _, tc := range tests {
+		config := goslugify.NewSlugConfig()
+		config.AllowUnicode = true
+		config.Confusables = tc.mode
+		got := config.Configure().GenerateSlug(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected slug (confusable mode %d) of \"%s\" to be \"%s\", but got \"%s\"",
+				tc.mode, tc.in, tc.expected, got)
+		}
+	}
+	config := goslugify.NewSlugConfig()
+	config.AllowUnicode = true
+	config.Confusables = goslugify.ConfusableReject
+	if config.GetValidator()("раypal") {
+		t.Error("expected \"раypal\" not to be a valid slug")
+	}
+}
+
+func TestConfusableFoldStable(t *testing.T) {
+	tests := []struct {
+		in, expected string
+	}{
+		{"стоlл", "сто\u04cfл"},
+		{"жpaypal login", "login"},
+		{"раypal", "paypal"},
+		{"жpaypal", ""},
+	}
+	config := goslugify.NewSlugConfig()
+	config.AllowUnicode = true
+	config.Confusables = goslugify.ConfusableFold
+	validator := config.GetValidator()
+	for _, tc := range tests {
+		// the result must not depend on the order of ConfusableTable
+		for i := 0; i < 20; i++ {
+			got := config.Configure().GenerateSlug(tc.in)
+			if got != tc.expected {
+				t.Errorf("expected folded slug of \"%s\" to be \"%s\", but got \"%s\"", tc.in, tc.expected, got)
+				break
+			}
+			if got != "" && !validator(got) {
+				t.Errorf("expected \"%s\" to be a valid slug", got)
+				break
+			}
+		}
+	}
+}
+
+func TestLowerCaseFunc(t *testing.T) {
+	tests := []struct {
+		languages    []string
+		in, expected string
+	}{
+		{nil, "Foo BAR", "foo bar"},
+		{nil, "ΟΔΥΣΣΕΥΣ", "οδυσσευς"},
+		{nil, "İSTANBUL", "i\u0307stanbul"},
+		{[]string{"tr"}, "İSTANBUL IĞDIR", "istanbul ığdır"},
+		{[]string{"de", "az"}, "İSTANBUL", "istanbul"},
+		{[]string{"de"}, "IĞDIR", "iğdir"},
+	}
+	for _, tc := range tests {
+		got := goslugify.NewLowerCaseFunc(tc.languages...)(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected lower case of \"%s\" (languages %v) to be \"%s\", but got \"%s\"",
+				tc.in, tc.languages, tc.expected, got)
+		}
+	}
+}
+
+func TestCaseFoldFunc(t *testing.T) {
+	tests := []struct {
+		languages    []string
+		in, expected string
+	}{
+		{nil, "Straße", "strasse"},
+		{nil, "ΟΔΥΣΣΕΥΣ", "οδυσσευσ"},
+		{[]string{"tr"}, "İSTANBUL", "istanbul"},
+	}
+	for _, tc := range tests {
+		got := goslugify.NewCaseFoldFunc(tc.languages...)(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected case folding of \"%s\" (languages %v) to be \"%s\", but got \"%s\"",
+				tc.in, tc.languages, tc.expected, got)
+		}
+	}
+}
+
+func TestCaseSlug(t *testing.T) {
+	tests := []struct {
+		languages    []string
+		allowUnicode bool
+		foldCase     bool
+		in, expected string
+	}{
+		{nil, false, false, "İSTANBUL", "istanbul"},
+		{[]string{"tr"}, false, false, "İSTANBUL IĞDIR", "istanbul-igdir"},
+		{[]string{"az"}, true, false, "İSTANBUL IĞDIR", "istanbul-ığdır"},
+		{nil, true, false, "İSTANBUL", "i\u0307stanbul"},
+		{nil, true, false, "ΟΔΥΣΣΕΥΣ", "οδυσσευς"},
+		{nil, true, true, "ΟΔΥΣΣΕΥΣ", "οδυσσευσ"},
+		{nil, true, true, "STRAẞE Straße", "strasse-strasse"},
+		{nil, true, true, "15 Μαΐου", "15-μαΐου"},
+	}
+	for _, tc := range tests {
+		config := goslugify.NewSlugConfig()
+		config.Languages = tc.languages
+		config.AllowUnicode = tc.allowUnicode
+		config.FoldCase = tc.foldCase
+		got := config.Configure().GenerateSlug(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected slug of \"%s\" (languages %v, unicode %v, fold %v) to be \"%s\", but got \"%s\"",
+				tc.in, tc.languages, tc.allowUnicode, tc.foldCase, tc.expected, got)
+		}
+		if !config.GetValidator()(got) {
+			t.Errorf("expected \"%s\" to be a valid slug", got)
+		}
+	}
+}
+
+func TestStopWordFilter(t *testing.T) {
+	stopWords := goslugify.NewStopWords("the", "of", "a", "as well as")
+	tests := []struct {
+		keepFirst, keepIfEmpty bool
+		in, expected           string
+	}{
+		{false, true, "the-most-interesting-species-of-rodents", "most-interesting-species-rodents"},
+		{false, true, "The-Beatles", "Beatles"},
+		{true, true, "the-who", "the-who"},
+		{true, true, "the-of-who", "the-who"},
+		{false, true, "cats-as-well-as-dogs", "cats-dogs"},
+		{false, true, "as-well", "as-well"},
+		{false, true, "the-a-of", "the-a-of"},
+		{false, false, "the-a-of", ""},
+		{false, true, "", ""},
+	}
+	for _, tc := range tests {
+		filter := goslugify.NewStopWordFilter(stopWords, "-")
+		filter.KeepFirst = tc.keepFirst
+		filter.KeepIfEmpty = tc.keepIfEmpty
+		got := filter.Modify(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected stop words of \"%s\" (keep first %v, keep if empty %v) to be removed as \"%s\", but got \"%s\"",
+				tc.in, tc.keepFirst, tc.keepIfEmpty, tc.expected, got)
+		}
+	}
+}
+
+func TestStopWordsSlug(t *testing.T) {
+	tests := []struct {
+		language     string
+		allowUnicode bool
+		in, expected string
+	}{
+		{"", false, "The most interesting species of rodents", "most-interesting-species-rodents"},
+		{"", false, "Tom & Jerry", "tom-jerry"},
+		{"", false, "The The", "the-the"},
+		{"de", false, "Ein Herz für Kinder", "herz-kinder"},
+		{"de-AT", false, "Über den Wolken", "wolken"},
+		{"ru", false, "Война и мир", "voyna-mir"},
+		{"ru", true, "Война и мир", "война-мир"},
+		{"el", false, "Η μάχη του Μαραθώνα", "machi-marathona"},
+		{"fr", false, "Le Petit Prince", "le-petit-prince"},
+		{"sv", false, "Pippi på de sju haven", "pippi-sju-haven"},
+		{"vi", false, "Cô gái và con cá là đá", "co-gai-va-con-ca-la-da"},
+		{"vi", false, "Tuy nhiên lá vẫn xanh", "la-van-xanh"},
+		{"vi", true, "Tuy nhiên lá vẫn xanh", "lá-vẫn-xanh"},
+	}
+	for _, tc := range tests {
+		config := goslugify.NewSlugConfig()
+		config.Language = tc.language
+		config.AllowUnicode = tc.allowUnicode
+		config.RemoveStopWords = true
+		got := config.Configure().GenerateSlug(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected slug of \"%s\" (language %s) without stop words to be \"%s\", but got \"%s\"",
+				tc.in, tc.language, tc.expected, got)
+		}
+	}
+
+	config := goslugify.NewSlugConfig()
+	config.RemoveStopWords = true
+	config.KeepFirstStopWord = true
+	config.TruncateLength = 15
+	if got := config.Configure().GenerateSlug("The best of the rest of the world"); got != "the-best-rest" {
+		t.Errorf("expected slug \"the-best-rest\", but got \"%s\"", got)
+	}
+	config.KeepStopWordsIfEmpty = false
+	config.KeepFirstStopWord = false
+	if got := config.Configure().GenerateSlug("To be or not to be"); got != "not" {
+		t.Errorf("expected slug \"not\", but got \"%s\"", got)
+	}
+	if got := config.Configure().GenerateSlug("The Who"); got != "who" {
+		t.Errorf("expected slug \"who\", but got \"%s\"", got)
+	}
+	if got := config.Configure().GenerateSlug("The"); got != "" {
+		t.Errorf("expected empty slug, but got \"%s\"", got)
+	}
+}
@@ -0,0 +1,811 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"fmt"
+	"github.com/FabianWe/goslugify"
+	"sync"
+	"testing"
+)
+
+func TestTableTransliterator(t *testing.T) {
+	transliterator := goslugify.NewTableTransliterator(
+		goslugify.StringReplaceMap{"a": "x", "ab": "yz", "ж": "zh"},
+		goslugify.StringReplaceMap{"b": "initial"},
+		goslugify.StringReplaceMap{"c": "final"},
+	)
+	tests := []struct {
+		in, expected string
+	}{
+		{"", ""},
+		{"a", "x"},
+		{"ab", "yz"},
+		{"aab", "xyz"},
+		{"b bb", "initial initialb"},
+		{"c cc", "final cfinal"},
+		{"ж Ж ЖЖ Жa", "zh Zh ZHZH Zhx"},
+	}
+	for _, tc := range tests {
+		got := transliterator.Modify(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected transliteration of \"%s\" to be \"%s\", but got \"%s\"",
+				tc.in, tc.expected, got)
+		}
+	}
+}
+
+func TestCyrillicLanguages(t *testing.T) {
+	tests := []struct {
+		language, in, expected string
+	}{
+		{"ru", "Юрий Гагарин", "yuriy-gagarin"},
+		{"ru", "Ёлка и ель", "yelka-i-yel"},
+		{"ru", "Щука & подъезд", "shchuka-i-podyezd"},
+		{"uk", "Згорани", "zghorany"},
+		{"uk", "Київ, Україна", "kyiv-ukraina"},
+		{"uk", "Юрій Яблуневий", "yurii-yablunevyi"},
+		{"bg", "Щастие в България", "shtastie-v-balgaria"},
+		{"sr", "Ђоковић у Љубљани", "djokovic-u-ljubljani"},
+		{"mk", "Ѓорѓе Џамбазов", "gjorgje-dzhambazov"},
+		{"be", "Магілёў", "mahilyow"},
+	}
+	for _, tc := range tests {
+		config := goslugify.NewSlugConfig()
+		config.AddLanguage(tc.language)
+		got := config.Configure().GenerateSlug(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected slug of \"%s\" (language %s) to be \"%s\", but got \"%s\"",
+				tc.in, tc.language, tc.expected, got)
+		}
+	}
+}
+
+func TestCyrillicStandards(t *testing.T) {
+	tests := []struct {
+		standard     goslugify.CyrillicStandard
+		language     string
+		in, expected string
+	}{
+		{goslugify.CyrillicISO9, "ru", "Щука", "Ŝuka"},
+		{goslugify.CyrillicISO9, "uk", "Їжак", "Ïžak"},
+		{goslugify.CyrillicGOST779B, "ru", "Царица Цыган", "Czaricza Cy`gan"},
+		{goslugify.CyrillicGOST779B, "uk", "Київ", "Ky`yiv"},
+		{goslugify.CyrillicGOST779B, "bg", "България", "Ba`lgariya"},
+		{goslugify.CyrillicBGNPCGN, "ru", "Щука", "Shchuka"},
+	}
+	for _, tc := range tests {
+		got := goslugify.NewCyrillicTransliterator(tc.standard, tc.language).Modify(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected transliteration of \"%s\" (standard %d, language %s) to be \"%s\", but got \"%s\"",
+				tc.in, tc.standard, tc.language, tc.expected, got)
+		}
+	}
+}
+
+func TestTransliterateGreek(t *testing.T) {
+	tests := []struct {
+		in, expected string
+	}{
+		{"Αθήνα", "Athina"},
+		{"Ευαγγέλιο", "Evangelio"},
+		{"αυτός", "aftos"},
+		{"Παύλος", "Pavlos"},
+		{"ευχαριστώ", "efcharisto"},
+		{"Μπαμπάς", "Bambas"},
+		{"ΜΠΑΜΠΑΣ", "BAMBAS"},
+		{"Ψυχή", "Psychi"},
+		{"Αϊδίνιο", "Aidinio"},
+		{"θεσσαλονίκη", "thessaloniki"},
+		{"γξ γχ", "nx nch"},
+		{"foo", "foo"},
+	}
+	for _, tc := range tests {
+		got := goslugify.TransliterateGreek(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected TransliterateGreek(\"%s\") to be \"%s\", but got \"%s\"",
+				tc.in, tc.expected, got)
+		}
+	}
+}
+
+func TestPinyinTransliterator(t *testing.T) {
+	config := goslugify.NewSlugConfig()
+	config.AddLanguage("zh")
+	generator := config.Configure()
+	tests := []struct {
+		in, expected string
+	}{
+		{"北京大学", "bei-jing-da-xue"},
+		{"我在银行工作", "wo-zai-yin-hang-gong-zuo"},
+		{"重庆火锅", "chong-qing-huo-guo"},
+		{"重要", "zhong-yao"},
+		{"iPhone手机评测", "iphone-shou-ji-ping-ce"},
+		{"長城 & 故宮", "chang-cheng-he-gu-gong"},
+		{"女", "nv"},
+	}
+	for _, tc := range tests {
+		got := generator.GenerateSlug(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected slug of \"%s\" (language zh) to be \"%s\", but got \"%s\"",
+				tc.in, tc.expected, got)
+		}
+	}
+}
+
+func TestPinyinWordSeparator(t *testing.T) {
+	config := goslugify.NewSlugConfig()
+	config.AddLanguage("zh")
+	config.WordSeparator = '_'
+	in, expected := "北京大学", "bei_jing_da_xue"
+	if got := config.Configure().GenerateSlug(in); got != expected {
+		t.Errorf("expected slug of \"%s\" with separator '_' to be \"%s\", but got \"%s\"",
+			in, expected, got)
+	}
+}
+
+func TestJapaneseTransliterator(t *testing.T) {
+	transliterator := goslugify.NewJapaneseTransliterator(nil)
+	tests := []struct {
+		in, expected string
+	}{
+		{"とうきょう", "tōkyō"},
+		{"おおさか", "ōsaka"},
+		{"まっちゃ", "matcha"},
+		{"きっぷ", "kippu"},
+		{"きんえん", "kin'en"},
+		{"しんよう", "shin'yō"},
+		{"じゃんけん", "janken"},
+		{"コーヒー", "kōhī"},
+		{"ティッシュ", "tisshu"},
+		{"ヴァイオリン", "vaiorin"},
+		{"ラーメン、すし", "rāmen sushi"},
+		{"いい", "ii"},
+		{"っっ", ""},
+		{"東京", "東京"},
+	}
+	for _, tc := range tests {
+		got := transliterator.Modify(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected romanization of \"%s\" to be \"%s\", but got \"%s\"",
+				tc.in, tc.expected, got)
+		}
+	}
+}
+
+func TestJapaneseSlug(t *testing.T) {
+	config := goslugify.NewSlugConfig()
+	config.AddLanguage("ja")
+	generator := config.Configure()
+	tests := []struct {
+		in, expected string
+	}{
+		{"東京タワー", "tokyo-tawa"},
+		{"日本の猫", "nihon-no-neko"},
+		{"ｶﾀｶﾅ", "katakana"},
+		{"がっこう & 先生", "gakko-to-sensei"},
+	}
+	for _, tc := range tests {
+		got := generator.GenerateSlug(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected slug of \"%s\" (language ja) to be \"%s\", but got \"%s\"",
+				tc.in, tc.expected, got)
+		}
+	}
+}
+
+func TestTransliterateKorean(t *testing.T) {
+	tests := []struct {
+		in, expected string
+	}{
+		{"", ""},
+		{"서울", "seoul"},
+		{"부산", "busan"},
+		{"한국어", "hangugeo"},
+		{"국민", "gungmin"},
+		{"독립", "dongnip"},
+		{"왕십리", "wangsimni"},
+		{"신라", "silla"},
+		{"설날", "seollal"},
+		{"종로", "jongno"},
+		{"좋고", "joko"},
+		{"놓다", "nota"},
+		{"같이", "gachi"},
+		{"굳이", "guji"},
+		{"닭", "dak"},
+		{"안녕하세요", "annyeonghaseyo"},
+		{"서울 123", "seoul 123"},
+	}
+	for _, tc := range tests {
+		got := goslugify.TransliterateKorean(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected romanization of \"%s\" to be \"%s\", but got \"%s\"",
+				tc.in, tc.expected, got)
+		}
+	}
+}
+
+func TestKoreanSlug(t *testing.T) {
+	config := goslugify.NewSlugConfig()
+	config.AddLanguage("ko")
+	generator := config.Configure()
+	tests := []struct {
+		in, expected string
+	}{
+		{"대한민국", "daehanminguk"},
+		{"서울특별시 제주도", "seoulteukbyeolsi-jejudo"},
+		{"대한민국 & 서울", "daehanminguk-gwa-seoul"},
+		{"ㄱ", "g"},
+	}
+	for _, tc := range tests {
+		got := generator.GenerateSlug(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected slug of \"%s\" (language ko) to be \"%s\", but got \"%s\"",
+				tc.in, tc.expected, got)
+		}
+	}
+}
+
+func TestArabicScript(t *testing.T) {
+	tests := []struct {
+		f            goslugify.RuneHandleFunc
+		in, expected string
+	}{
+		{goslugify.TransliterateArabic, "مَرْحَبًا", "mrhba"},
+		{goslugify.TransliterateArabic, "القاهرة", "alqahrh"},
+		{goslugify.TransliterateArabic, "ـــمحمد", "mhmd"},
+		{goslugify.TransliterateArabic, "٢٠٢٠", "2020"},
+		{goslugify.TransliterateArabic, "‏ذهب‬", "dhhb"},
+		{goslugify.TransliterateArabic, "لماذا؟", "lmadha?"},
+		{goslugify.TransliteratePersian, "تهران", "thran"},
+		{goslugify.TransliteratePersian, "ذوب", "zvb"},
+		{goslugify.TransliteratePersian, "۱۴۰۰", "1400"},
+		{goslugify.TransliteratePersian, "می‌خواهم", "mykhvahm"},
+		{goslugify.TransliterateUrdu, "پاکستان", "pakstan"},
+		{goslugify.TransliterateUrdu, "لاہور", "lahwr"},
+		{goslugify.TransliterateUrdu, "بڑے", "bre"},
+		{goslugify.TransliterateUrdu, "ٹوپی", "twpy"},
+	}
+	for _, tc := range tests {
+		got := goslugify.RuneHandleFuncToStringModifierFunc(tc.f)(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected transliteration of \"%s\" to be \"%s\", but got \"%s\"",
+				tc.in, tc.expected, got)
+		}
+	}
+}
+
+func TestArabicScriptSlug(t *testing.T) {
+	tests := []struct {
+		language, in, expected string
+	}{
+		{"ar", "أخبار & رياضة ٢٠٢٠", "akhbar-wa-ryadh-2020"},
+		{"ar", "ﻻ", "la"},
+		{"fa", "اخبار & ورزش", "akhbar-va-vrzsh"},
+		{"ur", "لاہور & کراچی", "lahwr-aur-krachy"},
+	}
+	for _, tc := range tests {
+		config := goslugify.NewSlugConfig()
+		config.AddLanguage(tc.language)
+		got := config.Configure().GenerateSlug(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected slug of \"%s\" (language %s) to be \"%s\", but got \"%s\"",
+				tc.in, tc.language, tc.expected, got)
+		}
+	}
+}
+
+func TestTransliterateHebrew(t *testing.T) {
+	tests := []struct {
+		in, expected string
+	}{
+		{"", ""},
+		{"שָׁלוֹם", "shlom"},
+		{"שלום", "shlom"},
+		{"בית ספר", "bit sfr"},
+		{"כּוּס", "kus"},
+		{"שׂמחה", "smhh"},
+		{"ג׳ירפה", "jirfh"},
+		{"מלך", "mlkh"},
+		{"סוף", "sof"},
+		{"ארץ", "rts"},
+		{"חיים", "hym"},
+		{"צה״ל", "tshl"},
+		{"בֵּית־לֶחֶם", "bit-lhm"},
+		{"abc 12", "abc 12"},
+	}
+	for _, tc := range tests {
+		got := goslugify.TransliterateHebrew(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected romanization of \"%s\" to be \"%s\", but got \"%s\"",
+				tc.in, tc.expected, got)
+		}
+	}
+}
+
+func TestHebrewSlug(t *testing.T) {
+	config := goslugify.NewSlugConfig()
+	config.AddLanguage("he")
+	generator := config.Configure()
+	tests := []struct {
+		in, expected string
+	}{
+		{"ירושלים & תל אביב", "yroshlim-ve-tl-viv"},
+		{"בְּרֵאשִׁית", "brshit"},
+	}
+	for _, tc := range tests {
+		got := generator.GenerateSlug(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected slug of \"%s\" (language he) to be \"%s\", but got \"%s\"",
+				tc.in, tc.expected, got)
+		}
+	}
+}
+
+func TestIndicTransliterator(t *testing.T) {
+	hindi := goslugify.NewIndicTransliterator(true)
+	iso := goslugify.NewIndicTransliterator(false)
+	tests := []struct {
+		transliterator *goslugify.IndicTransliterator
+		in, expected   string
+	}{
+		{hindi, "", ""},
+		{hindi, "हिन्दी", "hindi"},
+		{hindi, "हिंदी", "hindi"},
+		{hindi, "भारत", "bharat"},
+		{iso, "भारत", "bharata"},
+		{hindi, "न", "na"},
+		{hindi, "पत्र", "patra"},
+		{hindi, "मुंबई", "mumbai"},
+		{hindi, "गंगा", "ganga"},
+		{hindi, "शंकर", "sangkar"},
+		{hindi, "বাংলা", "bangla"},
+		{hindi, "সংবাদ", "sangbad"},
+		{hindi, "संस्कृत", "sanskrt"},
+		{hindi, "ज़िंदगी", "zindagi"},
+		{hindi, "नमः।", "namah."},
+		{hindi, "१२३४", "1234"},
+		{hindi, "नेपाल 2020", "nepal 2020"},
+		{hindi, "কলকাতা", "kalakata"},
+		{iso, "தமிழ்", "tamil"},
+		{iso, "சென்னை", "cennai"},
+		{iso, "తెలుగు", "telugu"},
+		{iso, "హైదరాబాద్", "haidarabad"},
+		{iso, "മലയാളം", "malayalam"},
+		{iso, "പൗരൻ", "pauran"},
+		{iso, "ਪੰਜਾਬ", "panjaba"},
+	}
+	for _, tc := range tests {
+		got := tc.transliterator.Modify(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected romanization of \"%s\" to be \"%s\", but got \"%s\"",
+				tc.in, tc.expected, got)
+		}
+	}
+}
+
+func TestIndicSlug(t *testing.T) {
+	tests := []struct {
+		language, in, expected string
+	}{
+		{"hi", "नमस्ते भारत & नेपाल", "namaste-bharat-aur-nepal"},
+		{"hi", "फ़िल्म", "filma"},
+		{"mr", "मराठी", "marathi"},
+		{"bn", "বাংলা", "bangla"},
+		{"ta", "தமிழ் நாடு", "tamil-natu"},
+		{"te", "తెలుగు", "telugu"},
+	}
+	for _, tc := range tests {
+		config := goslugify.NewSlugConfig()
+		config.AddLanguage(tc.language)
+		got := config.Configure().GenerateSlug(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected slug of \"%s\" (language %s) to be \"%s\", but got \"%s\"",
+				tc.in, tc.language, tc.expected, got)
+		}
+	}
+}
+
+func TestTransliterateThai(t *testing.T) {
+	tests := []struct {
+		in, expected string
+	}{
+		{"", ""},
+		{"สวัสดี", "sawatdi"},
+		{"ประเทศไทย", "prathetthai"},
+		{"กรุงเทพ", "krungthep"},
+		{"กรุงเทพมหานคร", "krungthepmahanakhon"},
+		{"นคร", "nakhon"},
+		{"ถนน", "thanon"},
+		{"ประชาชน", "prachachon"},
+		{"สามคน", "samkhon"},
+		{"คนไทย", "khonthai"},
+		{"เชียงใหม่", "chiangmai"},
+		{"ภูเก็ต", "phuket"},
+		{"ขอบคุณ", "khopkhun"},
+		{"น้ำ", "nam"},
+		{"จันทร์", "chan"},
+		{"สวน", "suan"},
+		{"แมว", "maeo"},
+		{"เลย", "loei"},
+		{"เมือง", "mueang"},
+		{"หนังสือ", "nangsue"},
+		{"อยู่", "yu"},
+		{"เกาะ", "ko"},
+		{"๒๕๖๓", "2563"},
+	}
+	for _, tc := range tests {
+		got := goslugify.TransliterateThai(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected romanization of \"%s\" to be \"%s\", but got \"%s\"",
+				tc.in, tc.expected, got)
+		}
+	}
+}
+
+func TestTransliterateLaoKhmer(t *testing.T) {
+	tests := []struct {
+		f            goslugify.StringModifierFunc
+		in, expected string
+	}{
+		{goslugify.TransliterateLao, "ວຽງຈັນ", "viangchan"},
+		{goslugify.TransliterateLao, "ລາວ", "lao"},
+		{goslugify.TransliterateLao, "ຫຼວງພະບາງ", "luangphabang"},
+		{goslugify.TransliterateLao, "ສະບາຍດີ", "sabaidi"},
+		{goslugify.TransliterateLao, "໒໐໒໐", "2020"},
+		{goslugify.TransliterateKhmer, "កម្ពុជា", "kampuchea"},
+		{goslugify.TransliterateKhmer, "ភ្នំពេញ", "phnumpenh"},
+		{goslugify.TransliterateKhmer, "សៀមរាប", "siemreab"},
+		{goslugify.TransliterateKhmer, "បាត់ដំបង", "batdambang"},
+		{goslugify.TransliterateKhmer, "កា គា", "ka kea"},
+		{goslugify.TransliterateKhmer, "២០២០", "2020"},
+	}
+	for _, tc := range tests {
+		got := tc.f(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected romanization of \"%s\" to be \"%s\", but got \"%s\"",
+				tc.in, tc.expected, got)
+		}
+	}
+}
+
+func TestSoutheastAsianSlug(t *testing.T) {
+	tests := []struct {
+		language, in, expected string
+	}{
+		{"th", "ภาษาไทย & ลาว", "phasathai-lae-lao"},
+		{"lo", "ປະເທດລາວ", "pathetlao"},
+		{"km", "ភាសាខ្មែរ", "pheasakhmaer"},
+	}
+	for _, tc := range tests {
+		config := goslugify.NewSlugConfig()
+		config.AddLanguage(tc.language)
+		got := config.Configure().GenerateSlug(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected slug of \"%s\" (language %s) to be \"%s\", but got \"%s\"",
+				tc.in, tc.language, tc.expected, got)
+		}
+	}
+}
+
+func TestArmenianGeorgianEthiopic(t *testing.T) {
+	armenian := goslugify.NewArmenianTransliterator()
+	tests := []struct {
+		f            goslugify.StringModifierFunc
+		in, expected string
+	}{
+		{armenian.Modify, "Երևան", "Yerevan"},
+		{armenian.Modify, "Հայաստան", "Hayastan"},
+		{armenian.Modify, "գյումրի", "gyumri"},
+		{armenian.Modify, "ոսկի ով", "voski ov"},
+		{armenian.Modify, "էջմիածին", "ejmiatsin"},
+		{goslugify.RuneHandleFuncToStringModifierFunc(armenian.HandleRune), "երեւան", "erewan"},
+		{goslugify.RuneHandleFuncToStringModifierFunc(goslugify.TransliterateGeorgian), "თბილისი", "tbilisi"},
+		{goslugify.RuneHandleFuncToStringModifierFunc(goslugify.TransliterateGeorgian), "ᲡᲐᲥᲐᲠᲗᲕᲔᲚᲝ", "sakartvelo"},
+		{goslugify.RuneHandleFuncToStringModifierFunc(goslugify.TransliterateEthiopic), "ሰላም", "selam"},
+		{goslugify.RuneHandleFuncToStringModifierFunc(goslugify.TransliterateEthiopic), "ኢትዮጵያ", "ityopya"},
+		{goslugify.RuneHandleFuncToStringModifierFunc(goslugify.TransliterateEthiopic), "አዲስ፡አበባ።", "adis abeba."},
+		{goslugify.RuneHandleFuncToStringModifierFunc(goslugify.TransliterateEthiopic), "ጐንደር", "gwender"},
+	}
+	for _, tc := range tests {
+		got := tc.f(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected transliteration of \"%s\" to be \"%s\", but got \"%s\"",
+				tc.in, tc.expected, got)
+		}
+	}
+}
+
+func TestArmenianGeorgianEthiopicSlug(t *testing.T) {
+	tests := []struct {
+		language, in, expected string
+	}{
+		{"hy", "Մայր Աթոռ Սուրբ Էջմիածին", "mayr-ator-surb-ejmiatsin"},
+		{"ka", "საქართველო & ბათუმი", "sakartvelo-da-batumi"},
+		{"am", "አዲስ አበባ", "adis-abeba"},
+	}
+	for _, tc := range tests {
+		config := goslugify.NewSlugConfig()
+		config.AddLanguage(tc.language)
+		got := config.Configure().GenerateSlug(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected slug of \"%s\" (language %s) to be \"%s\", but got \"%s\"",
+				tc.in, tc.language, tc.expected, got)
+		}
+	}
+}
+
+func TestTransliterateVietnamese(t *testing.T) {
+	tests := []struct {
+		in, expected string
+	}{
+		{"", ""},
+		{"Tiếng Việt", "Tieng Viet"},
+		{"Đà Nẵng", "Da Nang"},
+		{"người ở đâu", "nguoi o dau"},
+		{"ỮỨỴ", "UUY"},
+		// decomposed input (not normalized)
+		{"Vie\u0302\u0323t", "Viet"},
+		{"u\u031b\u0300", "u"},
+	}
+	f := goslugify.RuneHandleFuncToStringModifierFunc(goslugify.ChainRuneHandleFuncs(goslugify.TransliterateVietnamese,
+		goslugify.KeepAllFunc))
+	for _, tc := range tests {
+		got := f(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected transliteration of \"%s\" to be \"%s\", but got \"%s\"",
+				tc.in, tc.expected, got)
+		}
+	}
+}
+
+func TestVietnameseSlug(t *testing.T) {
+	config := goslugify.NewSlugConfig()
+	config.AddLanguage("vi")
+	generator := config.Configure()
+	tests := []struct {
+		in, expected string
+	}{
+		{"Tiếng Việt", "tieng-viet"},
+		{"Thủ đô Hà Nội & Đà Nẵng", "thu-do-ha-noi-va-da-nang"},
+		{"ĐƯỜNG PHỐ", "duong-pho"},
+	}
+	for _, tc := range tests {
+		got := generator.GenerateSlug(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected slug of \"%s\" (language vi) to be \"%s\", but got \"%s\"",
+				tc.in, tc.expected, got)
+		}
+	}
+}
+
+func TestLatinLanguages(t *testing.T) {
+	tests := []struct {
+		language, in, expected string
+	}{
+		{"", "Smørrebrød Göteborg", "smorrebrod-goeteborg"},
+		{"da", "Smørrebrød Ærø Åland", "smoerrebroed-aeroe-aaland"},
+		{"nb", "Tromsø & Ålesund", "tromsoe-og-aalesund"},
+		{"sv", "Göteborg Malmö Västerås", "goteborg-malmo-vasteras"},
+		{"fi", "Hämeenlinna Jyväskylä", "hameenlinna-jyvaskyla"},
+		{"nl", "coördinatie IJsselmeer ĳs", "coordinatie-ijsselmeer-ijs"},
+		{"tr", "Işık Şişli Ğ İstanbul", "isik-sisli-g-istanbul"},
+		{"tr", "Gölcük Üsküdar", "golcuk-uskudar"},
+		{"is", "Þingvellir Reykjavík Ðað", "thingvellir-reykjavik-dad"},
+		{"pl", "Łódź Świętokrzyskie", "lodz-swietokrzyskie"},
+		{"cs", "Příliš žluťoučký kůň", "prilis-zlutoucky-kun"},
+		{"sk", "Bánovce Ľubochňa", "banovce-lubochna"},
+		{"hu", "Győr Fővárosi Ünnep", "gyor-fovarosi-unnep"},
+		{"ro", "București Timișoara Ţară", "bucuresti-timisoara-tara"},
+	}
+	for _, tc := range tests {
+		config := goslugify.NewSlugConfig()
+		if tc.language != "" {
+			config.AddLanguage(tc.language)
+		}
+		got := config.Configure().GenerateSlug(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected slug of \"%s\" (language %s) to be \"%s\", but got \"%s\"",
+				tc.in, tc.language, tc.expected, got)
+		}
+	}
+}
+
+func TestLetterReplaceMap(t *testing.T) {
+	m := goslugify.LetterReplaceMap(map[rune]string{'æ': "ae", 'ß': "ss"})
+	expected := goslugify.StringReplaceMap{"æ": "ae", "Æ": "Ae", "ß": "ss"}
+	if len(m) != len(expected) {
+		t.Errorf("expected letter map %v, but got %v", expected, m)
+	}
+	for key, value := range expected {
+		if m[key] != value {
+			t.Errorf("expected \"%s\" to be mapped to \"%s\", but got \"%s\"", key, value, m[key])
+		}
+	}
+}
+
+func TestLanguageFallbacks(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected []string
+	}{
+		{"de", []string{"de", "und"}},
+		{"de-CH", []string{"de-CH", "de", "und"}},
+		{"de_at", []string{"de-AT", "de", "und"}},
+		{"en-GB", []string{"en-GB", "en-001", "en", "und"}},
+		{"zh-TW", []string{"zh-TW", "zh-Hant", "zh", "und"}},
+		{"sr-Latn", []string{"sr-Latn", "sr", "und"}},
+		{"und", []string{"und"}},
+		{"not a tag", []string{"not a tag"}},
+	}
+	for _, tc := range tests {
+		got := goslugify.LanguageFallbacks(tc.in)
+		if len(got) != len(tc.expected) {
+			t.Errorf("expected fallbacks of \"%s\" to be %v, but got %v", tc.in, tc.expected, got)
+			continue
+		}
+		for i := range got {
+			if got[i] != tc.expected[i] {
+				t.Errorf("expected fallbacks of \"%s\" to be %v, but got %v", tc.in, tc.expected, got)
+				break
+			}
+		}
+	}
+}
+
+func TestLanguageTagSlug(t *testing.T) {
+	registry := goslugify.DefaultLanguageRegistry.Clone()
+	registry.AddMap("de-LI", goslugify.StringReplaceMap{"fl": "fürstentum liechtenstein"})
+	tests := []struct {
+		language     string
+		allowUnicode bool
+		in, expected string
+	}{
+		{"de-AT", false, "Äpfel & Birnen", "aepfel-und-birnen"},
+		{"de-AT", false, "1. Jänner 2021", "1-januar-2021"},
+		{"de-AT", false, "1. JÄNNER 2021", "1-januar-2021"},
+		{"de-AT", false, "FEBER", "februar"},
+		{"de-AT", false, "Jännerwetter im Feber", "jaennerwetter-im-februar"},
+		{"de-AT", true, "Jännerwetter im Jänner", "jännerwetter-im-januar"},
+		{"de", false, "1. Jänner 2021", "1-jaenner-2021"},
+		{"de-CH", false, "1. Jänner 2021", "1-jaenner-2021"},
+		{"en-GB", false, "Fish & Chips 50%", "fish-and-chips-50-percent"},
+		{"ru-RU", false, "Юрий Гагарин", "yuriy-gagarin"},
+		{"sv-FI", false, "Malmö", "malmo"},
+		{"de-CH", true, "Straße", "strasse"},
+		{"de", true, "Straße", "straße"},
+		{"tr-TR", true, "İSTANBUL", "istanbul"},
+		{"de-LI", false, "FL & CH", "fuerstentum-liechtenstein-und-ch"},
+		{"zh-TW", false, "北京", "bei-jing"},
+	}
+	for _, tc := range tests {
+		config := goslugify.NewSlugConfig()
+		config.Language = tc.language
+		config.Registry = registry
+		config.AllowUnicode = tc.allowUnicode
+		got := config.Configure().GenerateSlug(tc.in)
+		if got != tc.expected {
+			t.Errorf("expected slug of \"%s\" (language %s) to be \"%s\", but got \"%s\"",
+				tc.in, tc.language, tc.expected, got)
+		}
+	}
+}
+
+func TestLanguageRegistry(t *testing.T) {
+	registry := goslugify.NewLanguageRegistry()
+	if languages := registry.Languages(); len(languages) != 0 {
+		t.Errorf("expected new registry to be empty, but got %v", languages)
+	}
+	registry.AddMap("de", goslugify.StringReplaceMap{"&": "und"})
+	registry.AddMap("de_at", goslugify.StringReplaceMap{"&": "und auch"})
+	registry.AddTransliterator("ru", goslugify.GetLanguageTransliterator("ru"))
+	languages := registry.Languages()
+	expected := []string{"de", "de-AT", "ru"}
+	if len(languages) != len(expected) {
+		t.Fatalf("expected languages %v, but got %v", expected, languages)
+	}
+	for i := range expected {
+		if languages[i] != expected[i] {
+			t.Errorf("expected languages %v, but got %v", expected, languages)
+			break
+		}
+	}
+
+	config := goslugify.NewSlugConfig()
+	config.Registry = registry
+	config.AddLanguage("de-AT", "ru")
+	if got := config.Configure().GenerateSlug("Äpfel & Жук"); got != "aepfel-und-auch-zhuk" {
+		t.Errorf("expected slug \"aepfel-und-auch-zhuk\", but got \"%s\"", got)
+	}
+	// the symbol names of the default registry are not used
+	if got := config.Configure().GenerateSlug("50%"); got != "50" {
+		t.Errorf("expected slug \"50\", but got \"%s\"", got)
+	}
+
+	if !registry.Remove("de-AT") || registry.Remove("de-AT") {
+		t.Error("expected \"de-AT\" to be removed exactly once")
+	}
+	if !registry.RemoveTransliterator("ru") || registry.RemoveMap("ru") {
+		t.Error("expected only the transliterator of \"ru\" to be removed")
+	}
+	if got := config.Configure().GenerateSlug("Äpfel & Жук"); got != "aepfel-und" {
+		t.Errorf("expected slug \"aepfel-und\", but got \"%s\"", got)
+	}
+	if got := goslugify.GetLanguageMap("de-AT")["&"]; got != "und" {
+		t.Errorf("expected the default registry not to be changed, but \"&\" is mapped to \"%s\"", got)
+	}
+}
+
+func TestLanguageRegistryConcurrent(t *testing.T) {
+	registry := goslugify.DefaultLanguageRegistry.Clone()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		language := fmt.Sprintf("x-test%d", i)
+		go func() {
+			defer wg.Done()
+			registry.AddMap(language, goslugify.StringReplaceMap{"&": "and"})
+			registry.Remove(language)
+		}()
+		go func() {
+			defer wg.Done()
+			config := goslugify.NewSlugConfig()
+			config.Registry = registry
+			config.AddLanguage("en", language)
+			if got := config.Configure().GenerateSlug("A & B"); got != "a-and-b" {
+				t.Errorf("expected slug \"a-and-b\", but got \"%s\"", got)
+			}
+			registry.Languages()
+		}()
+	}
+	wg.Wait()
+}
+
+func TestLanguageRegistryStopWords(t *testing.T) {
+	for _, language := range goslugify.DefaultLanguageRegistry.Languages() {
+		if len(goslugify.GetStopWords(language)) == 0 {
+			t.Errorf("expected built-in language %s to have stop words", language)
+		}
+	}
+	registry := goslugify.DefaultLanguageRegistry.Clone()
+	registry.AddStopWords("de-AT", goslugify.NewStopWords("hiatz"))
+	stopWords := registry.GetStopWords("de-AT")
+	if !stopWords["hiatz"] || !stopWords["und"] {
+		t.Errorf("expected stop words of \"de-AT\" to contain \"hiatz\" and \"und\"")
+	}
+	if !registry.RemoveStopWords("de-AT") || registry.GetStopWords("de-AT")["hiatz"] {
+		t.Error("expected stop words of \"de-AT\" to be removed")
+	}
+	if goslugify.GetStopWords("de-AT")["hiatz"] {
+		t.Error("expected the default registry not to be changed")
+	}
+}
+
+func TestLanguageRegistryWords(t *testing.T) {
+	registry := goslugify.DefaultLanguageRegistry.Clone()
+	registry.AddWords("de-LI", goslugify.StringReplaceMap{"ländle": "liechtenstein"})
+	config := goslugify.NewSlugConfig()
+	config.Registry = registry
+	config.Language = "de-LI"
+	if got := config.Configure().GenerateSlug("Ländle & Ländlebahn"); got != "liechtenstein-und-laendlebahn" {
+		t.Errorf("expected slug \"liechtenstein-und-laendlebahn\", but got \"%s\"", got)
+	}
+	if !registry.RemoveWords("de-LI") || registry.Remove("de-LI") {
+		t.Error("expected the words of \"de-LI\" to be removed exactly once")
+	}
+	if got := goslugify.GetLanguageWords("de-AT")["jänner"]; got != "januar" {
+		t.Errorf("expected \"jänner\" to be mapped to \"januar\", but got \"%s\"", got)
+	}
+}
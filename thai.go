@@ -0,0 +1,368 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goslugify
+
+import "strings"
+
+// taiVowel is a vowel pattern: lead is the vowel written before the consonant (0 if there is none) and
+// follow the vowel signs after the consonant. If open is true the syllable can't have a final consonant.
+type taiVowel struct {
+	lead   rune
+	follow string
+	value  string
+	open   bool
+}
+
+// taiScript describes the Thai and Lao script, they have the same structure: Vowels can be written
+// before, above, below and after the consonant, the inherent vowel is not written and tone marks are
+// placed above the consonant.
+type taiScript struct {
+	initials  map[rune]string
+	finals    map[rune]string
+	others    map[rune]string
+	clusters  map[string]string
+	vowels    []taiVowel
+	leading   string
+	attaching string
+	tones     string
+	// silentH is silent before the sonorants
+	silentH   rune
+	sonorants string
+	// silencer is the sign that marks a consonant as silent
+	silencer rune
+	// medialW is "ua" if written between two consonants
+	medialW rune
+	// finalY is omitted after "ai"
+	finalY    rune
+	digitZero rune
+}
+
+func (script *taiScript) isConsonant(r rune) bool {
+	_, has := script.initials[r]
+	return has
+}
+
+// removeSilent removes the tone marks and silent consonants (consonants marked with the silencer).
+func (script *taiScript) removeSilent(in string) []rune {
+	res := make([]rune, 0, len(in))
+	for _, r := range in {
+		switch {
+		case strings.ContainsRune(script.tones, r):
+			continue
+		case r == script.silencer:
+			// remove the silent consonant, it may carry a vowel sign
+			if len(res) > 0 && !script.isConsonant(res[len(res)-1]) {
+				res = res[:len(res)-1]
+			}
+			if len(res) > 0 && script.isConsonant(res[len(res)-1]) {
+				silent := res[len(res)-1]
+				res = res[:len(res)-1]
+				// a cluster before the silencer is silent as well ("ทร์")
+				if len(res) > 0 {
+					if _, isCluster := script.clusters[string([]rune{res[len(res)-1], silent})]; isCluster {
+						res = res[:len(res)-1]
+					}
+				}
+			}
+			continue
+		}
+		res = append(res, r)
+	}
+	return res
+}
+
+// startsSyllable returns true if the consonant at position i is the initial consonant of a syllable
+// (and thus not the final consonant of the syllable before).
+func (script *taiScript) startsSyllable(runes []rune, i int) bool {
+	if i+1 >= len(runes) {
+		return false
+	}
+	if strings.ContainsRune(script.attaching, runes[i+1]) {
+		return true
+	}
+	if _, isCluster := script.clusters[string(runes[i:i+2])]; isCluster {
+		return i+2 < len(runes) && strings.ContainsRune(script.attaching, runes[i+2])
+	}
+	return false
+}
+
+// wordFinalConsonants returns the number of consonants starting at runes[i] and true if they're the last
+// runes of the word. These consonants don't have a written vowel.
+func (script *taiScript) wordFinalConsonants(runes []rune, i int) (int, bool) {
+	n := 0
+	for i+n < len(runes) && script.isConsonant(runes[i+n]) {
+		n++
+	}
+	if i+n == len(runes) {
+		return n, true
+	}
+	next := runes[i+n]
+	isLetter := strings.ContainsRune(script.leading, next) || strings.ContainsRune(script.attaching, next) ||
+		strings.ContainsRune(script.tones, next)
+	return n, !isLetter
+}
+
+// startsWordFinalSyllable returns true if the runes starting at position i are at least two consonants at the
+// end of the word, thus the syllable before is open ("ถนน" is "tha-non").
+func (script *taiScript) startsWordFinalSyllable(runes []rune, i int) bool {
+	n, atEnd := script.wordFinalConsonants(runes, i)
+	return n >= 2 && atEnd
+}
+
+// isFinal returns true if the consonant at position i is the final consonant of the syllable before.
+// At the end of a word the last two consonants without a vowel are read as consonant, "o" and final
+// consonant and all consonants before as consonant and "a", for example "นคร" is "na-khon".
+// Thus if the consonant is followed by exactly one consonant at the end of the word or by two that could form
+// a cluster it starts a syllable ("ประชาชน" is "pra-cha-chon", "มหานคร" is "ma-ha-na-khon" and not
+// "ma-han-khon").
+func (script *taiScript) isFinal(runes []rune, i int) bool {
+	if i >= len(runes) || !script.isConsonant(runes[i]) || script.startsSyllable(runes, i) {
+		return false
+	}
+	n, atEnd := script.wordFinalConsonants(runes, i)
+	switch {
+	case atEnd && n == 2:
+		return false
+	case atEnd && n == 3:
+		_, isCluster := script.clusters[string(runes[i+1:i+3])]
+		return !isCluster
+	default:
+		return true
+	}
+}
+
+func hasRunePrefix(runes []rune, prefix string) (int, bool) {
+	n := 0
+	for _, r := range prefix {
+		if n >= len(runes) || runes[n] != r {
+			return 0, false
+		}
+		n++
+	}
+	return n, true
+}
+
+func (script *taiScript) transliterate(in string) string {
+	runes := script.removeSilent(in)
+	var buf strings.Builder
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		if r >= script.digitZero && r <= script.digitZero+9 {
+			buf.WriteRune('0' + r - script.digitZero)
+			i++
+			continue
+		}
+		var lead rune
+		if strings.ContainsRune(script.leading, r) {
+			lead = r
+			i++
+		}
+		if i >= len(runes) || !script.isConsonant(runes[i]) {
+			if lead != 0 {
+				// leading vowel without consonant
+				for _, vowel := range script.vowels {
+					if vowel.lead == lead && vowel.follow == "" {
+						buf.WriteString(vowel.value)
+					}
+				}
+				continue
+			}
+			if res, has := script.others[r]; has {
+				buf.WriteString(res)
+			} else if !strings.ContainsRune(script.attaching, r) {
+				buf.WriteRune(r)
+			}
+			i++
+			continue
+		}
+		// initial consonant or cluster
+		initial := script.initials[runes[i]]
+		if i+1 < len(runes) {
+			next := runes[i+1]
+			// at the end of a word the second consonant is the final one, "กร" is "kon" and not "kra"
+			n, atEnd := script.wordFinalConsonants(runes, i+1)
+			if cluster, has := script.clusters[string(runes[i:i+2])]; has && !(n == 1 && atEnd) {
+				initial = cluster
+				i++
+			} else if runes[i] == script.silentH && strings.ContainsRune(script.sonorants, next) {
+				initial = script.initials[next]
+				i++
+			}
+		}
+		i++
+		// vowel
+		var vowel *taiVowel
+		length := 0
+		for j := range script.vowels {
+			candidate := &script.vowels[j]
+			if candidate.lead != lead {
+				continue
+			}
+			if n, has := hasRunePrefix(runes[i:], candidate.follow); has && (vowel == nil || n > length) {
+				vowel, length = candidate, n
+			}
+		}
+		value := ""
+		open := false
+		switch {
+		case vowel != nil:
+			value, open = vowel.value, vowel.open
+			i += length
+		case i+1 < len(runes) && runes[i] == script.medialW && script.isConsonant(runes[i+1]) &&
+			!script.startsSyllable(runes, i+1):
+			value = "ua"
+			i++
+		case script.startsWordFinalSyllable(runes, i):
+			value, open = "a", true
+		case script.isFinal(runes, i):
+			value = "o"
+		default:
+			value, open = "a", true
+		}
+		buf.WriteString(initial)
+		buf.WriteString(value)
+		// final consonant
+		if !open && script.isFinal(runes, i) {
+			final := script.finals[runes[i]]
+			if runes[i] == script.finalY && strings.HasSuffix(value, "ai") {
+				final = ""
+			}
+			buf.WriteString(final)
+			i++
+		}
+	}
+	return buf.String()
+}
+
+var thaiScript = &taiScript{
+	initials: map[rune]string{
+		'ก': "k", 'ข': "kh", 'ฃ': "kh", 'ค': "kh", 'ฅ': "kh", 'ฆ': "kh", 'ง': "ng", 'จ': "ch", 'ฉ': "ch",
+		'ช': "ch", 'ซ': "s", 'ฌ': "ch", 'ญ': "y", 'ฎ': "d", 'ฏ': "t", 'ฐ': "th", 'ฑ': "th", 'ฒ': "th",
+		'ณ': "n", 'ด': "d", 'ต': "t", 'ถ': "th", 'ท': "th", 'ธ': "th", 'น': "n", 'บ': "b", 'ป': "p",
+		'ผ': "ph", 'ฝ': "f", 'พ': "ph", 'ฟ': "f", 'ภ': "ph", 'ม': "m", 'ย': "y", 'ร': "r", 'ล': "l",
+		'ว': "w", 'ศ': "s", 'ษ': "s", 'ส': "s", 'ห': "h", 'ฬ': "l", 'อ': "", 'ฮ': "h",
+	},
+	finals: map[rune]string{
+		'ก': "k", 'ข': "k", 'ฃ': "k", 'ค': "k", 'ฅ': "k", 'ฆ': "k", 'ง': "ng", 'จ': "t", 'ฉ': "t",
+		'ช': "t", 'ซ': "t", 'ฌ': "t", 'ญ': "n", 'ฎ': "t", 'ฏ': "t", 'ฐ': "t", 'ฑ': "t", 'ฒ': "t",
+		'ณ': "n", 'ด': "t", 'ต': "t", 'ถ': "t", 'ท': "t", 'ธ': "t", 'น': "n", 'บ': "p", 'ป': "p",
+		'ผ': "p", 'ฝ': "p", 'พ': "p", 'ฟ': "p", 'ภ': "p", 'ม': "m", 'ย': "i", 'ร': "n", 'ล': "n",
+		'ว': "o", 'ศ': "t", 'ษ': "t", 'ส': "t", 'ห': "", 'ฬ': "n", 'อ': "", 'ฮ': "",
+	},
+	others: map[rune]string{
+		'ฤ': "rue", 'ฦ': "lue", 'ๆ': "", 'ฯ': "", '๏': "", '๚': "", '๛': "", 'ฺ': "", '๎': "",
+	},
+	clusters: map[string]string{
+		"กร": "kr", "กล": "kl", "กว": "kw", "ขร": "khr", "ขล": "khl", "ขว": "khw", "คร": "khr", "คล": "khl",
+		"คว": "khw", "ปร": "pr", "ปล": "pl", "พร": "phr", "พล": "phl", "ผล": "phl", "ตร": "tr", "บร": "br",
+		"บล": "bl", "ดร": "dr", "ฟร": "fr", "ฟล": "fl", "จร": "ch", "ซร": "s", "ศร": "s", "สร": "s", "ทร": "s",
+		"อย": "y",
+	},
+	vowels: []taiVowel{
+		{0, "ัว", "ua", false}, {0, "ั", "a", false}, {0, "ะ", "a", true}, {0, "า", "a", false},
+		{0, "ำ", "am", true}, {0, "ํา", "am", true}, {0, "ิ", "i", false}, {0, "ี", "i", false},
+		{0, "ึ", "ue", false}, {0, "ื", "ue", false}, {0, "ือ", "ue", false}, {0, "ุ", "u", false},
+		{0, "ู", "u", false}, {0, "็", "o", false}, {0, "อ", "o", false}, {0, "รร", "an", false},
+		{0, "รรม", "am", true},
+		{'เ', "", "e", false}, {'เ', "ะ", "e", true}, {'เ', "็", "e", false}, {'เ', "ีย", "ia", false},
+		{'เ', "ือ", "uea", false}, {'เ', "าะ", "o", true}, {'เ', "า", "ao", true}, {'เ', "อ", "oe", false},
+		{'เ', "ิ", "oe", false}, {'เ', "ี", "oe", false}, {'เ', "ย", "oei", true},
+		{'แ', "", "ae", false}, {'แ', "ะ", "ae", true}, {'แ', "็", "ae", false},
+		{'โ', "", "o", false}, {'โ', "ะ", "o", true},
+		{'ไ', "", "ai", false}, {'ใ', "", "ai", false},
+	},
+	leading:   "เแโใไ",
+	attaching: "ะัาำิีึืฺุู็ํอ",
+	tones:     "่้๊๋",
+	silentH:   'ห',
+	sonorants: "งญนมยรลว",
+	silencer:  '์',
+	medialW:   'ว',
+	finalY:    'ย',
+	digitZero: '๐',
+}
+
+var laoScript = &taiScript{
+	initials: map[rune]string{
+		'ກ': "k", 'ຂ': "kh", 'ຄ': "kh", 'ງ': "ng", 'ຈ': "ch", 'ສ': "s", 'ຊ': "x", 'ຍ': "ny", 'ດ': "d",
+		'ຕ': "t", 'ຖ': "th", 'ທ': "th", 'ນ': "n", 'ບ': "b", 'ປ': "p", 'ຜ': "ph", 'ຝ': "f", 'ພ': "ph",
+		'ຟ': "f", 'ມ': "m", 'ຢ': "y", 'ຣ': "r", 'ລ': "l", 'ວ': "v", 'ຫ': "h", 'ອ': "", 'ຮ': "h",
+		'ໜ': "n", 'ໝ': "m",
+	},
+	finals: map[rune]string{
+		'ກ': "k", 'ຂ': "k", 'ຄ': "k", 'ງ': "ng", 'ຈ': "t", 'ສ': "t", 'ຊ': "t", 'ຍ': "i", 'ດ': "t",
+		'ຕ': "t", 'ຖ': "t", 'ທ': "t", 'ນ': "n", 'ບ': "p", 'ປ': "p", 'ຜ': "p", 'ຝ': "p", 'ພ': "p",
+		'ຟ': "p", 'ມ': "m", 'ຢ': "i", 'ຣ': "n", 'ລ': "n", 'ວ': "o", 'ຫ': "", 'ອ': "", 'ຮ': "",
+		'ໜ': "n", 'ໝ': "m",
+	},
+	others: map[rune]string{
+		'ໆ': "", 'ຯ': "", 'ຼ': "l",
+	},
+	clusters: map[string]string{
+		"ຫຼ": "l", "ກວ": "kv", "ຂວ": "khv", "ຄວ": "khv",
+	},
+	vowels: []taiVowel{
+		{0, "ົວ", "ua", false}, {0, "ັວ", "ua", false}, {0, "ັ", "a", false}, {0, "ະ", "a", true},
+		{0, "າ", "a", false}, {0, "ຳ", "am", true}, {0, "ໍາ", "am", true}, {0, "ິ", "i", false},
+		{0, "ີ", "i", false}, {0, "ຶ", "ue", false}, {0, "ື", "ue", false}, {0, "ຸ", "u", false},
+		{0, "ູ", "u", false}, {0, "ົ", "o", false}, {0, "ໍ", "o", false}, {0, "ອ", "o", false},
+		{0, "ຽ", "ia", false}, {0, "ັຽ", "ia", false},
+		{'ເ', "", "e", false}, {'ເ', "ະ", "e", true}, {'ເ', "ັ", "e", false}, {'ເ', "ຍ", "ia", false},
+		{'ເ', "ຶອ", "uea", false}, {'ເ', "ືອ", "uea", false}, {'ເ', "າະ", "o", true}, {'ເ', "ົາ", "ao", true},
+		{'ເ', "ິ", "oe", false}, {'ເ', "ີ", "oe", false},
+		{'ແ', "", "ae", false}, {'ແ', "ະ", "ae", true}, {'ແ', "ັ", "ae", false},
+		{'ໂ', "", "o", false}, {'ໂ', "ະ", "o", true},
+		{'ໄ', "", "ai", false}, {'ໃ', "", "ai", false},
+	},
+	leading:   "ເແໂໃໄ",
+	attaching: "ະັາຳິີຶືຸູົໍຽອ",
+	tones:     "່້໊໋",
+	silentH:   'ຫ',
+	sonorants: "ງຍນມຣລວ",
+	silencer:  '໌',
+	medialW:   'ວ',
+	finalY:    'ຍ',
+	digitZero: '໐',
+}
+
+// TransliterateThai is a StringModifierFunc that romanizes Thai based on the Royal Thai General System
+// of Transcription (RTGS), for example "สวัสดี" --> "sawatdi".
+//
+// Vowels written before the consonant ("เ", "แ", "โ", "ใ" and "ไ") are moved after it, tone marks are
+// removed and consonants marked with the thanthakhat ("์") are not pronounced and thus omitted.
+// Consonants have different values at the beginning and the end of a syllable ("บ" is "b" or "p").
+//
+// Note that Thai is written without spaces between words and the inherent vowel is not written, so the
+// syllable boundaries are guessed by simple rules without a dictionary and the result only approximates RTGS:
+// Consonants without a vowel at the end of a word are read as "a" syllables followed by one "o" syllable
+// ("กรุงเทพมหานคร" becomes "krungthepmahanakhon"), but loan words with unwritten vowels in the middle of
+// the word are often not romanized correctly ("สุวรรณภูมิ" becomes "suoronnaphumi" instead of
+// "suwannaphum"). The syllables are not separated, "ประเทศไทย" becomes "prathetthai".
+//
+// All runes that are not Thai are not changed.
+func TransliterateThai(in string) string {
+	return thaiScript.transliterate(in)
+}
+
+// TransliterateLao is a StringModifierFunc that romanizes Lao according to the BGN/PCGN system (without
+// diacritics), for example "ວຽງຈັນ" --> "viangchan".
+//
+// It works as TransliterateThai: Leading vowels are moved after the consonant, tone marks are removed
+// and consonants have different values at the end of a syllable.
+//
+// All runes that are not Lao are not changed.
+func TransliterateLao(in string) string {
+	return laoScript.transliterate(in)
+}
@@ -0,0 +1,168 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goslugify
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TableTransliterator is an implementation of StringModifier that transliterates a string given
+// replacement tables, it is used for most of the scripts supported by this package.
+//
+// All keys in the tables must be lower case, the transliterator takes care of upper case input:
+// "Ж" becomes "Zh" and "ЖУК" becomes "ZHUK" if "ж" is mapped to "zh".
+//
+// Keys can consist of more than one rune, this way rules that depend on the context can be
+// expressed, for example the Ukrainian "зг" --> "zgh" (instead of "zh").
+// The longest matching key always wins.
+//
+// InitialTable contains entries that are only used at the beginning of a word and FinalTable entries
+// that are only used at the end of a word. A word begins / ends where a rune is not a letter (apostrophes
+// are considered to be part of a word). Entries in these tables take precedence over entries of the same
+// length in Table.
+//
+// Runes for which no entry exists are kept unchanged.
+//
+// Note that you can change the tables of an existing transliterator, but only before Modify
+// is called for the first time.
+type TableTransliterator struct {
+	Table        StringReplaceMap
+	InitialTable StringReplaceMap
+	FinalTable   StringReplaceMap
+	maxKeyLen    int
+	once         *sync.Once
+}
+
+// NewTableTransliterator returns a new transliterator given the tables, initial and final may be nil.
+func NewTableTransliterator(table, initial, final StringReplaceMap) *TableTransliterator {
+	var once sync.Once
+	return &TableTransliterator{
+		Table:        table,
+		InitialTable: initial,
+		FinalTable:   final,
+		maxKeyLen:    0,
+		once:         &once,
+	}
+}
+
+func (t *TableTransliterator) computeMaxKeyLen() {
+	for _, m := range []StringReplaceMap{t.Table, t.InitialTable, t.FinalTable} {
+		for key := range m {
+			if l := utf8.RuneCountInString(key); l > t.maxKeyLen {
+				t.maxKeyLen = l
+			}
+		}
+	}
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsMark(r) || r == '\'' || r == '’'
+}
+
+func (t *TableTransliterator) match(runes []rune, pos int) (int, string, bool) {
+	isStart := pos == 0 || !isWordRune(runes[pos-1])
+	maxLen := t.maxKeyLen
+	if rest := len(runes) - pos; rest < maxLen {
+		maxLen = rest
+	}
+	for l := maxLen; l > 0; l-- {
+		key := string(runes[pos : pos+l])
+		if isStart {
+			if res, has := t.InitialTable[key]; has {
+				return l, res, true
+			}
+		}
+		if end := pos + l; end == len(runes) || !isWordRune(runes[end]) {
+			if res, has := t.FinalTable[key]; has {
+				return l, res, true
+			}
+		}
+		if res, has := t.Table[key]; has {
+			return l, res, true
+		}
+	}
+	return 0, "", false
+}
+
+// toTitle converts the first rune of s to upper case.
+func toTitle(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	if r == utf8.RuneError {
+		return s
+	}
+	return string(unicode.ToUpper(r)) + s[size:]
+}
+
+// applyCase converts the replacement res to upper case (or title case) if the original runes
+// in[from:to] are upper case.
+// If the context (the rune before or after the match) is upper case as well the whole string is
+// upper case, otherwise only the first rune.
+func applyCase(in []rune, from, to int, res string) string {
+	if !unicode.IsUpper(in[from]) {
+		return res
+	}
+	allUpper := to-from > 1
+	for _, r := range in[from+1 : to] {
+		if !unicode.IsUpper(r) {
+			allUpper = false
+		}
+	}
+	if !allUpper {
+		allUpper = (to < len(in) && unicode.IsUpper(in[to])) || (from > 0 && unicode.IsUpper(in[from-1]))
+	}
+	if allUpper {
+		return strings.ToUpper(res)
+	}
+	return toTitle(res)
+}
+
+// Modify transliterates the string.
+func (t *TableTransliterator) Modify(in string) string {
+	t.once.Do(t.computeMaxKeyLen)
+	runes := []rune(in)
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+	var buf strings.Builder
+	for i := 0; i < len(runes); {
+		n, res, ok := t.match(lower, i)
+		if !ok {
+			buf.WriteRune(runes[i])
+			i++
+			continue
+		}
+		buf.WriteString(applyCase(runes, i, i+n, res))
+		i += n
+	}
+	return buf.String()
+}
+
+// HandleRune is a RuneHandleFunc that only uses the single rune entries from Table, this way
+// the transliterator can be used in a chain of RuneHandleFuncs (but without the context rules).
+func (t *TableTransliterator) HandleRune(r rune) (bool, string) {
+	lower := unicode.ToLower(r)
+	res, has := t.Table[string(lower)]
+	if !has {
+		return false, ""
+	}
+	if lower != r {
+		return true, toTitle(res)
+	}
+	return true, res
+}
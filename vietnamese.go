@@ -0,0 +1,53 @@
+// Copyright 2020 Fabian Wenzelmann <fabianwen@posteo.eu>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goslugify
+
+import (
+	"golang.org/x/text/unicode/norm"
+	"strings"
+	"unicode/utf8"
+)
+
+// vietnameseLetters contains all letters of the Vietnamese alphabet that are not ASCII (with and without
+// tone marks), except "đ" and "Đ".
+const vietnameseLetters = "àáảãạăằắẳẵặâầấẩẫậèéẻẽẹêềếểễệìíỉĩịòóỏõọôồốổỗộơờớởỡợùúủũụưừứửữựỳýỷỹỵ" +
+	"ÀÁẢÃẠĂẰẮẲẴẶÂẦẤẨẪẬÈÉẺẼẸÊỀẾỂỄỆÌÍỈĨỊÒÓỎÕỌÔỒỐỔỖỘƠỜỚỞỠỢÙÚỦŨỤƯỪỨỬỮỰỲÝỶỸỴ"
+
+// vietnameseMarks contains the combining marks used in Vietnamese: the five tone marks (grave, acute,
+// hook above, tilde and dot below) and the marks for "ă", "â" / "ê" / "ô" and "ơ" / "ư".
+const vietnameseMarks = "̛̣̀́̉̃̆̂"
+
+// TransliterateVietnamese is a RuneHandleFunc that translates Vietnamese letters to ASCII by removing all
+// diacritics, including stacked ones like in "ệ" or "ở", for example "Tiếng Việt" --> "Tieng Viet".
+// "đ" and "Đ" become "d" and "D".
+//
+// Combining tone and vowel marks are removed as well, so the function also works on strings that are not
+// normalized (NFC) and the result doesn't depend on the normalization form used in the pre-processing.
+//
+// All other runes are not handled.
+func TransliterateVietnamese(r rune) (bool, string) {
+	switch {
+	case r == 'đ':
+		return true, "d"
+	case r == 'Đ':
+		return true, "D"
+	case strings.ContainsRune(vietnameseMarks, r):
+		return true, ""
+	case r < utf8.RuneSelf || !strings.ContainsRune(vietnameseLetters, r):
+		return false, ""
+	}
+	base, _ := utf8.DecodeRuneInString(norm.NFD.String(string(r)))
+	return true, string(base)
+}